@@ -0,0 +1,496 @@
+// Package alertmanager renders the configuration of the alert manager from notifiers, alerts and alert
+// groups. Alerts are matched by the labels the alertrule package puts on the rules it renders.
+package alertmanager
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rancher/types/alertrule"
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"github.com/rancher/types/notify"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// NullReceiver receives the alerts no route matches, it has no notifier
+	NullReceiver = "rancher-null"
+
+	defaultResolveTimeout = "5m"
+)
+
+var errNoRelay = errors.New("a relay is required to send to this notifier")
+
+type Config struct {
+	Global       *GlobalConfig `yaml:"global,omitempty"`
+	Route        *Route        `yaml:"route"`
+	InhibitRules []InhibitRule `yaml:"inhibit_rules,omitempty"`
+	Receivers    []Receiver    `yaml:"receivers"`
+	// Skipped lists the recipients that were left out because they need the relay and Input.RelayURL is
+	// empty, it is not rendered
+	Skipped []string `yaml:"-"`
+}
+
+type GlobalConfig struct {
+	ResolveTimeout string `yaml:"resolve_timeout,omitempty"`
+}
+
+type Route struct {
+	Receiver       string            `yaml:"receiver,omitempty"`
+	GroupBy        []string          `yaml:"group_by,omitempty"`
+	Match          map[string]string `yaml:"match,omitempty"`
+	GroupWait      string            `yaml:"group_wait,omitempty"`
+	GroupInterval  string            `yaml:"group_interval,omitempty"`
+	RepeatInterval string            `yaml:"repeat_interval,omitempty"`
+	Routes         []*Route          `yaml:"routes,omitempty"`
+}
+
+type InhibitRule struct {
+	SourceMatch map[string]string `yaml:"source_match,omitempty"`
+	TargetMatch map[string]string `yaml:"target_match,omitempty"`
+	Equal       []string          `yaml:"equal,omitempty"`
+}
+
+type Receiver struct {
+	Name             string            `yaml:"name"`
+	EmailConfigs     []EmailConfig     `yaml:"email_configs,omitempty"`
+	SlackConfigs     []SlackConfig     `yaml:"slack_configs,omitempty"`
+	PagerdutyConfigs []PagerdutyConfig `yaml:"pagerduty_configs,omitempty"`
+	WebhookConfigs   []WebhookConfig   `yaml:"webhook_configs,omitempty"`
+	OpsgenieConfigs  []OpsgenieConfig  `yaml:"opsgenie_configs,omitempty"`
+}
+
+type EmailConfig struct {
+	To           string `yaml:"to"`
+	From         string `yaml:"from"`
+	Smarthost    string `yaml:"smarthost"`
+	AuthUsername string `yaml:"auth_username,omitempty"`
+	AuthPassword string `yaml:"auth_password,omitempty"`
+	RequireTLS   *bool  `yaml:"require_tls,omitempty"`
+	// HTML is rendered empty so the message is sent as the text
+	HTML *string `yaml:"html,omitempty"`
+	Text string  `yaml:"text,omitempty"`
+}
+
+type SlackConfig struct {
+	APIURL  string `yaml:"api_url"`
+	Channel string `yaml:"channel,omitempty"`
+	Text    string `yaml:"text,omitempty"`
+}
+
+type PagerdutyConfig struct {
+	ServiceKey  string `yaml:"service_key"`
+	Description string `yaml:"description,omitempty"`
+}
+
+type WebhookConfig struct {
+	URL        string      `yaml:"url"`
+	HTTPConfig *HTTPConfig `yaml:"http_config,omitempty"`
+}
+
+type OpsgenieConfig struct {
+	APIKey string `yaml:"api_key"`
+	APIURL string `yaml:"api_url,omitempty"`
+	Teams  string `yaml:"teams,omitempty"`
+	Tags   string `yaml:"tags,omitempty"`
+	// Message is cut to the length opsgenie accepts by cutMessage, the description holds all of it
+	Message     string `yaml:"message,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+type HTTPConfig struct {
+	BasicAuth   *BasicAuth `yaml:"basic_auth,omitempty"`
+	BearerToken string     `yaml:"bearer_token,omitempty"`
+	TLSConfig   *TLSConfig `yaml:"tls_config,omitempty"`
+}
+
+type BasicAuth struct {
+	Username string `yaml:"username"`
+	Password string `yaml:"password,omitempty"`
+}
+
+// TLSConfig references files, the certificates of the notifier are written next to the config under the
+// names returned by Files
+type TLSConfig struct {
+	CAFile             string `yaml:"ca_file,omitempty"`
+	CertFile           string `yaml:"cert_file,omitempty"`
+	KeyFile            string `yaml:"key_file,omitempty"`
+	ServerName         string `yaml:"server_name,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify,omitempty"`
+}
+
+// Input holds the objects the config is built from, objects of other clusters should be left out
+type Input struct {
+	Notifiers          []*v3.Notifier
+	ClusterAlerts      []*v3.ClusterAlert
+	ProjectAlerts      []*v3.ProjectAlert
+	ClusterAlertGroups []*v3.ClusterAlertGroup
+	ProjectAlertGroups []*v3.ProjectAlertGroup
+	// RelayURL is the base URL of the relay that delivers the notifications the alert manager can not send
+	// itself, Microsoft Teams messages and webhooks with headers or a body template. The notifier is
+	// appended to it as the path. Without it these recipients are skipped.
+	RelayURL string
+	// CertDir is the directory the files returned by Files are mounted at
+	CertDir string
+}
+
+// alert is the part of cluster and project alerts the config is built from
+type alert struct {
+	id     string
+	group  string
+	common v3.AlertCommonSpec
+	fields notify.Alert
+}
+
+// firingFields are the fields of alerts that are only known when they fire, as alertmanager templates
+var firingFields = notify.Alert{
+	FiringTime: `{{ (index .Alerts 0).StartsAt.Format "2006-01-02T15:04:05Z07:00" }}`,
+	Message:    `{{ (index .Alerts 0).Annotations.` + alertrule.AnnotationMessage + ` }}`,
+}
+
+// Build returns the config that sends every active alert to its recipients. An alert has a route of its
+// own that matches its id and severity, alerts of a group are routed below the route of the group, which
+// sets the timing of the notifications. Recipients that need the relay are skipped if there is none, the
+// other recipients of their alerts are still notified. Silence windows are not part of the config, they
+// are enforced by the silences returned by Silences.
+func Build(input Input) (*Config, error) {
+	notifiers := map[string]*v3.Notifier{}
+	for _, notifier := range input.Notifiers {
+		notifiers[id(notifier.Namespace, notifier.Name)] = notifier
+	}
+
+	groups := map[string]v3.AlertGroupSpec{}
+	for _, group := range input.ClusterAlertGroups {
+		groups[id(group.Namespace, group.Name)] = group.Spec.AlertGroupSpec
+	}
+	for _, group := range input.ProjectAlertGroups {
+		groups[id(group.Namespace, group.Name)] = group.Spec.AlertGroupSpec
+	}
+
+	alerts := activeAlerts(input)
+	config := &Config{
+		Global: &GlobalConfig{ResolveTimeout: defaultResolveTimeout},
+		Route: &Route{
+			Receiver: NullReceiver,
+			GroupBy:  []string{alertrule.LabelAlertID},
+		},
+		Receivers: []Receiver{{Name: NullReceiver}},
+	}
+
+	groupRoutes := map[string]*Route{}
+	for _, a := range alerts {
+		receiver, skipped, err := buildReceiver(a, notifiers, input)
+		if err != nil {
+			return nil, fmt.Errorf("alert %s: %v", a.id, err)
+		}
+		for _, notifier := range skipped {
+			config.Skipped = append(config.Skipped, fmt.Sprintf("alert %s: notifier %s: %v", a.id, notifier, errNoRelay))
+		}
+		config.Receivers = append(config.Receivers, receiver)
+
+		route := &Route{
+			Receiver: receiver.Name,
+			Match: map[string]string{
+				alertrule.LabelAlertID:  a.id,
+				alertrule.LabelSeverity: a.common.Severity,
+			},
+		}
+
+		group, grouped := groups[a.group]
+		if !grouped {
+			route.GroupBy = []string{alertrule.LabelAlertID}
+			// an initial wait of zero notifies right away, so it is rendered rather than inherited
+			route.GroupWait = strconv.Itoa(a.common.InitialWaitSeconds) + "s"
+			route.RepeatInterval = seconds(a.common.RepeatIntervalSeconds)
+			config.Route.Routes = append(config.Route.Routes, route)
+		} else {
+			// the alerts of the group inherit its grouping and timing
+			groupRoute, ok := groupRoutes[a.group]
+			if !ok {
+				groupRoute = buildGroupRoute(a.group, group)
+				groupRoutes[a.group] = groupRoute
+				config.Route.Routes = append(config.Route.Routes, groupRoute)
+			}
+			groupRoute.Routes = append(groupRoute.Routes, route)
+		}
+
+		for _, rule := range a.common.InhibitRules {
+			config.InhibitRules = append(config.InhibitRules, InhibitRule{
+				SourceMatch: map[string]string{alertrule.LabelAlertID: a.id},
+				TargetMatch: rule.TargetMatchers,
+				Equal:       rule.Equal,
+			})
+		}
+	}
+
+	return config, nil
+}
+
+// activeAlerts returns the cluster and project alerts that are not inactive, sorted by id
+func activeAlerts(input Input) []alert {
+	var alerts []alert
+	for _, a := range input.ClusterAlerts {
+		if a.Status.AlertState != "inactive" {
+			alerts = append(alerts, alert{id: id(a.Namespace, a.Name), group: a.Spec.GroupName, common: a.Spec.AlertCommonSpec, fields: notify.ClusterAlertFields(a)})
+		}
+	}
+	for _, a := range input.ProjectAlerts {
+		if a.Status.AlertState != "inactive" {
+			alerts = append(alerts, alert{id: id(a.Namespace, a.Name), group: a.Spec.GroupName, common: a.Spec.AlertCommonSpec, fields: notify.ProjectAlertFields(a)})
+		}
+	}
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].id < alerts[j].id })
+	return alerts
+}
+
+// Render returns the config in the format the alert manager loads
+func Render(config *Config) ([]byte, error) {
+	return yaml.Marshal(config)
+}
+
+func buildGroupRoute(groupID string, group v3.AlertGroupSpec) *Route {
+	groupBy := append([]string{alertrule.LabelGroupID}, group.GroupBy...)
+	return &Route{
+		Receiver:       NullReceiver,
+		Match:          map[string]string{alertrule.LabelGroupID: groupID},
+		GroupBy:        groupBy,
+		GroupWait:      seconds(group.GroupWaitSeconds),
+		GroupInterval:  seconds(group.GroupIntervalSeconds),
+		RepeatInterval: seconds(group.RepeatIntervalSeconds),
+	}
+}
+
+// buildReceiver returns the receiver named after the alert that notifies all of its recipients, and the
+// notifiers of the recipients that were skipped because there is no relay
+func buildReceiver(a alert, notifiers map[string]*v3.Notifier, input Input) (Receiver, []string, error) {
+	receiver := Receiver{Name: a.id}
+	var skipped []string
+	for _, recipient := range a.common.Recipients {
+		notifier, ok := notifiers[recipient.NotifierName]
+		if !ok {
+			return receiver, nil, fmt.Errorf("notifier %s not found", recipient.NotifierName)
+		}
+		message, err := renderMessage(a, notifier.Spec)
+		if err != nil {
+			return receiver, nil, fmt.Errorf("notifier %s: %v", recipient.NotifierName, err)
+		}
+		err = addRecipient(&receiver, recipient, notifier, message, input)
+		switch {
+		case err == errNoRelay:
+			skipped = append(skipped, recipient.NotifierName)
+		case err != nil:
+			return receiver, nil, fmt.Errorf("notifier %s: %v", recipient.NotifierName, err)
+		}
+	}
+	return receiver, skipped, nil
+}
+
+// renderMessage renders the message template of the alert and the notifier into an alertmanager template.
+// The fields known before the alert fires are rendered, the others are left to alertmanager.
+func renderMessage(a alert, notifier v3.NotifierSpec) (string, error) {
+	fields := firingFields
+	fields.ID = alertrule.Escape(a.fields.ID)
+	fields.DisplayName = alertrule.Escape(a.fields.DisplayName)
+	fields.Description = alertrule.Escape(a.fields.Description)
+	fields.Severity = alertrule.Escape(a.fields.Severity)
+	fields.Type = a.fields.Type
+	fields.Target = alertrule.Escape(a.fields.Target)
+	fields.ClusterName = alertrule.Escape(a.fields.ClusterName)
+	fields.ProjectName = alertrule.Escape(a.fields.ProjectName)
+	return notify.RenderMessage(notify.MessageTemplate(a.common, notifier), fields)
+}
+
+// addRecipient adds the config of the notifier to the receiver, the recipient replaces the default
+// recipient of the notifier
+func addRecipient(receiver *Receiver, recipient v3.Recipient, n *v3.Notifier, message string, input Input) error {
+	notifier := n.Spec
+	switch {
+	case notifier.SMTPConfig != nil:
+		smtp := notifier.SMTPConfig
+		tls := smtp.TLS
+		html := ""
+		receiver.EmailConfigs = append(receiver.EmailConfigs, EmailConfig{
+			To:           or(recipient.Recipient, smtp.DefaultRecipient),
+			From:         smtp.Sender,
+			Smarthost:    net.JoinHostPort(smtp.Host, strconv.Itoa(smtp.Port)),
+			AuthUsername: smtp.Username,
+			AuthPassword: smtp.Password,
+			RequireTLS:   &tls,
+			HTML:         &html,
+			Text:         message,
+		})
+	case notifier.SlackConfig != nil:
+		receiver.SlackConfigs = append(receiver.SlackConfigs, SlackConfig{
+			APIURL:  notifier.SlackConfig.URL,
+			Channel: or(recipient.Recipient, notifier.SlackConfig.DefaultRecipient),
+			Text:    message,
+		})
+	case notifier.PagerdutyConfig != nil:
+		receiver.PagerdutyConfigs = append(receiver.PagerdutyConfigs, PagerdutyConfig{
+			ServiceKey:  or(recipient.Recipient, notifier.PagerdutyConfig.ServiceKey),
+			Description: message,
+		})
+	case notifier.WebhookConfig != nil:
+		webhook := notifier.WebhookConfig
+		if len(webhook.Headers) > 0 || webhook.BodyTemplate != "" {
+			// the alert manager posts its own payload without extra headers
+			return addRelay(receiver, recipient, n, message, input.RelayURL)
+		}
+		// the alert manager posts its own payload, which holds the alerts but not the message
+		receiver.WebhookConfigs = append(receiver.WebhookConfigs, WebhookConfig{
+			URL:        or(recipient.Recipient, webhook.URL),
+			HTTPConfig: buildHTTPConfig(id(n.Namespace, n.Name), webhook, input.CertDir),
+		})
+	case notifier.OpsgenieConfig != nil:
+		opsgenie := notifier.OpsgenieConfig
+		receiver.OpsgenieConfigs = append(receiver.OpsgenieConfigs, OpsgenieConfig{
+			APIKey:      opsgenie.APIKey,
+			APIURL:      opsgenie.APIURL,
+			Teams:       or(recipient.Recipient, opsgenie.DefaultRecipient),
+			Tags:        strings.Join(opsgenie.Tags, ","),
+			Message:     cutMessage(message, notify.OpsgenieMessageLength),
+			Description: message,
+		})
+	case notifier.MSTeamsConfig != nil:
+		return addRelay(receiver, recipient, n, message, input.RelayURL)
+	default:
+		return fmt.Errorf("notifier has no config")
+	}
+	return nil
+}
+
+// escapedDelimiters are the actions alertrule.Escape replaces the template delimiters of user values with
+var escapedDelimiters = []string{`{{ "{{" }}`, `{{ "}}" }}`}
+
+// cutMessage cuts an alertmanager template to at most length characters on rune boundaries without
+// splitting an action. Escaped delimiters count as the two characters they print, the other actions are
+// only known when the alert fires and count as their length in the template.
+func cutMessage(message string, length int) string {
+	count, end := 0, 0
+	for end < len(message) {
+		size, runes := 0, 0
+		for _, escaped := range escapedDelimiters {
+			if strings.HasPrefix(message[end:], escaped) {
+				size, runes = len(escaped), 2
+			}
+		}
+		if size == 0 && strings.HasPrefix(message[end:], "{{") {
+			if i := strings.Index(message[end:], "}}"); i >= 0 {
+				size = i + 2
+				runes = utf8.RuneCountInString(message[end : end+size])
+			}
+		}
+		if size == 0 {
+			_, size = utf8.DecodeRuneInString(message[end:])
+			runes = 1
+		}
+
+		if count+runes > length {
+			break
+		}
+		count += runes
+		end += size
+	}
+	return message[:end]
+}
+
+// addRelay adds a webhook that posts the alerts to the relay, which sends them with the notifier. The
+// recipient and the message are passed as query parameters, the message is an alertmanager template the
+// relay executes with the payload of the webhook.
+func addRelay(receiver *Receiver, recipient v3.Recipient, n *v3.Notifier, message, relayURL string) error {
+	if relayURL == "" {
+		return errNoRelay
+	}
+	u, err := url.Parse(relayURL)
+	if err != nil {
+		return fmt.Errorf("invalid relay URL [%s]: %v", relayURL, err)
+	}
+	u.Path = path.Join(u.Path, n.Namespace, n.Name)
+	query := url.Values{}
+	if recipient.Recipient != "" {
+		query.Set("recipient", recipient.Recipient)
+	}
+	if message != "" {
+		query.Set("message", message)
+	}
+	u.RawQuery = query.Encode()
+	receiver.WebhookConfigs = append(receiver.WebhookConfigs, WebhookConfig{URL: u.String()})
+	return nil
+}
+
+func buildHTTPConfig(notifierID string, webhook *v3.WebhookConfig, certDir string) *HTTPConfig {
+	config := &HTTPConfig{BearerToken: webhook.BearerToken}
+	if webhook.BasicAuth != nil {
+		config.BasicAuth = &BasicAuth{
+			Username: webhook.BasicAuth.Username,
+			Password: webhook.BasicAuth.Password,
+		}
+	}
+	if tlsConfig := webhook.TLSConfig; tlsConfig != nil {
+		config.TLSConfig = &TLSConfig{
+			ServerName:         tlsConfig.ServerName,
+			InsecureSkipVerify: tlsConfig.InsecureSkipVerify,
+		}
+		if tlsConfig.CACert != "" {
+			config.TLSConfig.CAFile = path.Join(certDir, certFile(notifierID, "ca.pem"))
+		}
+		if tlsConfig.ClientCert != "" {
+			config.TLSConfig.CertFile = path.Join(certDir, certFile(notifierID, "cert.pem"))
+			config.TLSConfig.KeyFile = path.Join(certDir, certFile(notifierID, "key.pem"))
+		}
+	}
+	if *config == (HTTPConfig{}) {
+		return nil
+	}
+	return config
+}
+
+// Files returns the certificates the TLS configs of the webhooks reference by file name, relative to
+// Input.CertDir
+func Files(notifiers []*v3.Notifier) map[string]string {
+	files := map[string]string{}
+	for _, n := range notifiers {
+		if n.Spec.WebhookConfig == nil || n.Spec.WebhookConfig.TLSConfig == nil {
+			continue
+		}
+		notifierID := id(n.Namespace, n.Name)
+		tlsConfig := n.Spec.WebhookConfig.TLSConfig
+		if tlsConfig.CACert != "" {
+			files[certFile(notifierID, "ca.pem")] = tlsConfig.CACert
+		}
+		if tlsConfig.ClientCert != "" {
+			files[certFile(notifierID, "cert.pem")] = tlsConfig.ClientCert
+			files[certFile(notifierID, "key.pem")] = tlsConfig.ClientKey
+		}
+	}
+	return files
+}
+
+func certFile(notifierID, name string) string {
+	return strings.Replace(notifierID, ":", "_", -1) + "_" + name
+}
+
+func id(namespace, name string) string {
+	return namespace + ":" + name
+}
+
+func seconds(value int) string {
+	if value <= 0 {
+		return ""
+	}
+	return strconv.Itoa(value) + "s"
+}
+
+func or(value, fallback string) string {
+	if value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,131 @@
+package alertmanager
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"github.com/rancher/types/internal/golden"
+)
+
+func TestBuild(t *testing.T) {
+	golden.Run(t, func() interface{} { return &Input{} }, func(input interface{}) (string, error) {
+		config, err := Build(*input.(*Input))
+		if err != nil {
+			return "", err
+		}
+		data, err := Render(config)
+		for _, skipped := range config.Skipped {
+			data = append(data, "# skipped: "+skipped+"\n"...)
+		}
+		return string(data), err
+	})
+}
+
+func TestCutMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		length   int
+		expected string
+	}{
+		{
+			name:     "short",
+			message:  "disk full",
+			length:   130,
+			expected: "disk full",
+		},
+		{
+			// every character is two bytes, cutting by bytes would split one
+			name:     "runes",
+			message:  strings.Repeat("é", 131),
+			length:   130,
+			expected: strings.Repeat("é", 130),
+		},
+		{
+			name:     "escaped delimiters count as printed",
+			message:  `ab {{ "{{" }}x{{ "}}" }} cd`,
+			length:   9,
+			expected: `ab {{ "{{" }}x{{ "}}" }} `,
+		},
+		{
+			name:     "escaped delimiter is not split",
+			message:  `ab {{ "{{" }}x`,
+			length:   4,
+			expected: "ab ",
+		},
+		{
+			name:     "action is not split",
+			message:  "node: {{ .Labels.node }} is down",
+			length:   20,
+			expected: "node: ",
+		},
+		{
+			name:     "action that fits",
+			message:  "node: {{ .Labels.node }} is down",
+			length:   26,
+			expected: "node: {{ .Labels.node }} i",
+		},
+	}
+
+	for _, test := range tests {
+		if cut := cutMessage(test.message, test.length); cut != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, cut)
+		}
+	}
+}
+
+func TestFiles(t *testing.T) {
+	notifier := &v3.Notifier{Spec: v3.NotifierSpec{WebhookConfig: &v3.WebhookConfig{
+		URL:       "https://hooks.example.com/secure",
+		TLSConfig: &v3.HTTPTLSConfig{CACert: "ca", ClientCert: "cert", ClientKey: "key"},
+	}}}
+	notifier.Namespace = "c-1"
+	notifier.Name = "n-webhook-tls"
+
+	expected := map[string]string{
+		"c-1_n-webhook-tls_ca.pem":   "ca",
+		"c-1_n-webhook-tls_cert.pem": "cert",
+		"c-1_n-webhook-tls_key.pem":  "key",
+	}
+	if files := Files([]*v3.Notifier{notifier}); !reflect.DeepEqual(files, expected) {
+		t.Errorf("expected files %v, got %v", expected, files)
+	}
+}
+
+func TestSilences(t *testing.T) {
+	alert := &v3.ClusterAlert{Spec: v3.ClusterAlertSpec{AlertCommonSpec: v3.AlertCommonSpec{
+		SilenceWindows: []v3.SilenceWindow{
+			{Start: "2026-03-01T22:00:00Z", End: "2026-03-02T02:00:00Z", Recurrence: "daily", Comment: "backups"},
+			{Start: "2026-03-01T10:00:00Z", End: "2026-03-01T12:00:00Z"},
+			{Start: "2026-04-01T10:00:00Z", End: "2026-04-01T12:00:00Z"},
+		},
+	}}}
+	alert.Namespace = "c-1"
+	alert.Name = "alert-memory"
+	inactive := alert.DeepCopy()
+	inactive.Name = "alert-inactive"
+	inactive.Status.AlertState = "inactive"
+
+	now := parseTime("2026-03-05T01:00:00Z")
+	silences, err := Silences(Input{ClusterAlerts: []*v3.ClusterAlert{alert, inactive}}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	matchers := []Matcher{{Name: "alert_id", Value: "c-1:alert-memory"}}
+	expected := []Silence{
+		{Matchers: matchers, StartsAt: parseTime("2026-03-04T22:00:00Z"), EndsAt: parseTime("2026-03-05T02:00:00Z"), CreatedBy: SilenceCreator, Comment: "backups"},
+		{Matchers: matchers, StartsAt: parseTime("2026-04-01T10:00:00Z"), EndsAt: parseTime("2026-04-01T12:00:00Z"), CreatedBy: SilenceCreator, Comment: "silence window of alert c-1:alert-memory"},
+	}
+	if !reflect.DeepEqual(silences, expected) {
+		t.Errorf("expected silences %+v, got %+v", expected, silences)
+	}
+}
+
+func parseTime(value string) time.Time {
+	result, _ := time.Parse(time.RFC3339, value)
+	return result
+}
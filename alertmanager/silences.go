@@ -0,0 +1,54 @@
+package alertmanager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rancher/types/alertrule"
+	"github.com/rancher/types/silence"
+)
+
+// SilenceCreator is the creator of the silences returned by Silences
+const SilenceCreator = "rancher"
+
+// Silence is a silence in the format of the alert manager API. The alert manager config has no silences, they
+// are posted to the API.
+type Silence struct {
+	Matchers  []Matcher `json:"matchers"`
+	StartsAt  time.Time `json:"startsAt"`
+	EndsAt    time.Time `json:"endsAt"`
+	CreatedBy string    `json:"createdBy"`
+	Comment   string    `json:"comment"`
+}
+
+type Matcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+}
+
+// Silences returns the silences that enforce the silence windows of the alerts that are not inactive, one
+// for the current or next occurrence of every window. They match the alert by id, so they should be
+// posted again once an occurrence ended.
+func Silences(input Input, now time.Time) ([]Silence, error) {
+	var result []Silence
+	for _, a := range activeAlerts(input) {
+		for _, window := range a.common.SilenceWindows {
+			occurrence, ok, err := silence.Next(window, now)
+			if err != nil {
+				return nil, fmt.Errorf("alert %s: %v", a.id, err)
+			}
+			if !ok {
+				continue
+			}
+			result = append(result, Silence{
+				Matchers:  []Matcher{{Name: alertrule.LabelAlertID, Value: a.id}},
+				StartsAt:  occurrence.Start,
+				EndsAt:    occurrence.End,
+				CreatedBy: SilenceCreator,
+				Comment:   or(window.Comment, "silence window of alert "+a.id),
+			})
+		}
+	}
+	return result, nil
+}
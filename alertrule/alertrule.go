@@ -0,0 +1,454 @@
+// Package alertrule renders cluster and project alerts into prometheus rule files. The node, pod and
+// workload targets are expressed with the metrics of kube-state-metrics and node-exporter, node-exporter
+// series are expected to carry the name of their node in the node label.
+package alertrule
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"github.com/rancher/types/promql"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	LabelAlertID     = "alert_id"
+	LabelAlertType   = "alert_type"
+	LabelSeverity    = "severity"
+	LabelClusterName = "cluster_name"
+	LabelProjectName = "project_name"
+	LabelGroupID     = "group_id"
+
+	// AnnotationMessage holds the condition that fired, templated with the labels and value of the series
+	AnnotationMessage = "message"
+
+	// projectIDLabel is the label of kube-state-metrics that holds the project of a namespace
+	projectIDLabel = "label_field_cattle_io_projectId"
+)
+
+// Escape keeps prometheus and alertmanager from executing the template delimiters of a value set by users,
+// when it is put in an annotation or a message template
+func Escape(value string) string {
+	return strings.NewReplacer("{{", `{{ "{{" }}`, "}}", `{{ "}}" }}`).Replace(value)
+}
+
+// ErrNotSupported is returned for targets that are not evaluated by prometheus, such as events
+var ErrNotSupported = errors.New("target is not evaluated by prometheus")
+
+var (
+	invalidNameChars  = regexp.MustCompile(`[^a-zA-Z0-9_:]`)
+	invalidLabelChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+)
+
+// workloadMetrics maps the kinds of workloads to the label of kube-state-metrics that holds their name and
+// the metrics of their available and desired replicas
+var workloadMetrics = map[string]struct {
+	label, available, desired string
+}{
+	"deployment":  {"deployment", "kube_deployment_status_replicas_available", "kube_deployment_spec_replicas"},
+	"daemonset":   {"daemonset", "kube_daemonset_status_number_available", "kube_daemonset_status_desired_number_scheduled"},
+	"statefulset": {"statefulset", "kube_statefulset_status_replicas_ready", "kube_statefulset_replicas"},
+}
+
+// comparisonPhrases describe the operators of metric rules in the messages of their alerts
+var comparisonPhrases = map[string]string{
+	"==": "equal to",
+	"!=": "not equal to",
+	">":  "greater than",
+	"<":  "less than",
+	">=": "greater than or equal to",
+	"<=": "less than or equal to",
+}
+
+var systemServiceJobs = map[string]string{
+	"etcd":               "etcd",
+	"controller-manager": "kube-controller-manager",
+	"scheduler":          "kube-scheduler",
+}
+
+// NodeLister gets nodes by namespace and name, the generated v3 NodeLister satisfies it
+type NodeLister interface {
+	Get(namespace, name string) (*v3.Node, error)
+}
+
+type File struct {
+	Groups []Group `yaml:"groups"`
+}
+
+type Group struct {
+	Name  string `yaml:"name"`
+	Rules []Rule `yaml:"rules"`
+}
+
+type Rule struct {
+	Alert       string            `yaml:"alert"`
+	Expr        string            `yaml:"expr"`
+	For         string            `yaml:"for,omitempty"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// Build returns a rule file with a group per cluster and project. Inactive alerts and alerts with targets
+// that are not evaluated by prometheus are left out.
+func Build(clusterAlerts []*v3.ClusterAlert, projectAlerts []*v3.ProjectAlert, nodes NodeLister) (*File, error) {
+	groups := map[string][]Rule{}
+
+	for _, alert := range clusterAlerts {
+		if alert.Status.AlertState == "inactive" {
+			continue
+		}
+		rule, err := ClusterAlertRule(alert, nodes)
+		if err == ErrNotSupported {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("cluster alert %s: %v", alert.Name, err)
+		}
+		name := "cluster-" + alert.Spec.ClusterName
+		groups[name] = append(groups[name], *rule)
+	}
+
+	for _, alert := range projectAlerts {
+		if alert.Status.AlertState == "inactive" {
+			continue
+		}
+		rule, err := ProjectAlertRule(alert)
+		if err == ErrNotSupported {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("project alert %s: %v", alert.Name, err)
+		}
+		name := "project-" + strings.Replace(alert.Spec.ProjectName, ":", "-", -1)
+		groups[name] = append(groups[name], *rule)
+	}
+
+	file := &File{}
+	for _, name := range sortedGroupNames(groups) {
+		rules := groups[name]
+		sort.Slice(rules, func(i, j int) bool { return rules[i].Alert < rules[j].Alert })
+		file.Groups = append(file.Groups, Group{Name: name, Rules: rules})
+	}
+	return file, nil
+}
+
+// Render returns the rule file in the format prometheus loads
+func Render(file *File) ([]byte, error) {
+	return yaml.Marshal(file)
+}
+
+// ClusterAlertRule returns the rule that fires for alert, ErrNotSupported when its target is not evaluated
+// by prometheus
+func ClusterAlertRule(alert *v3.ClusterAlert, nodes NodeLister) (*Rule, error) {
+	spec := alert.Spec
+	var (
+		alertType string
+		rule      *Rule
+		err       error
+	)
+	switch {
+	case spec.TargetMetric != nil:
+		alertType = "metric"
+		rule, err = metricRule(spec.TargetMetric, "")
+	case spec.TargetNode != nil:
+		alertType = "node"
+		rule, err = nodeRule(spec.TargetNode, nodes)
+	case spec.TargetSystemService != nil:
+		alertType = "systemService"
+		rule, err = systemServiceRule(spec.TargetSystemService)
+	default:
+		return nil, ErrNotSupported
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	finish(rule, alert.Namespace, alert.Name, alertType, spec.GroupName, spec.AlertCommonSpec)
+	rule.Labels[LabelClusterName] = spec.ClusterName
+	return rule, check(rule)
+}
+
+// ProjectAlertRule returns the rule that fires for alert, ErrNotSupported when its target is not evaluated
+// by prometheus. Metric rules and workloads selected by labels are limited to the series of the namespaces
+// of the project.
+func ProjectAlertRule(alert *v3.ProjectAlert) (*Rule, error) {
+	spec := alert.Spec
+	var (
+		alertType string
+		rule      *Rule
+		err       error
+	)
+	switch {
+	case spec.TargetMetric != nil:
+		alertType = "metric"
+		rule, err = metricRule(spec.TargetMetric, projectName(spec.ProjectName))
+	case spec.TargetPod != nil:
+		alertType = "pod"
+		rule, err = podRule(spec.TargetPod)
+	case spec.TargetWorkload != nil:
+		alertType = "workload"
+		rule, err = workloadRule(spec.TargetWorkload, projectName(spec.ProjectName))
+	default:
+		return nil, ErrNotSupported
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	finish(rule, alert.Namespace, alert.Name, alertType, spec.GroupName, spec.AlertCommonSpec)
+	rule.Labels[LabelProjectName] = spec.ProjectName
+	return rule, check(rule)
+}
+
+// metricRule compares the expression with the threshold, the series of project alerts are limited to the
+// namespaces of project
+func metricRule(metric *v3.MetricRule, project string) (*Rule, error) {
+	if result := metric.Validate(); len(result.Errors) > 0 {
+		return nil, result.Err()
+	}
+
+	comparison := v3.MetricRuleComparisons[metric.Comparison]
+	if comparison == "" {
+		comparison = "=="
+	}
+	expr := fmt.Sprintf("(%s) %s %s", metric.Expression, comparison, strconv.FormatFloat(metric.ThresholdValue, 'g', -1, 64))
+	if project != "" {
+		expr = fmt.Sprintf("(%s) and on(namespace) %s", expr, projectNamespaces(project))
+	}
+
+	rule := &Rule{
+		Expr: expr,
+		For:  metric.Duration,
+		Annotations: map[string]string{
+			AnnotationMessage: fmt.Sprintf("the value {{ $value }} is %s %s", comparisonPhrases[comparison], strconv.FormatFloat(metric.ThresholdValue, 'g', -1, 64)),
+		},
+	}
+	if metric.Description != "" {
+		rule.Annotations["description"] = metric.Description
+	}
+	return rule, nil
+}
+
+func nodeRule(target *v3.TargetNode, nodes NodeLister) (*Rule, error) {
+	nodeMatcher := ""
+	if target.NodeName != "" {
+		name, err := nodeName(target.NodeName, nodes)
+		if err != nil {
+			return nil, err
+		}
+		nodeMatcher = "node=" + strconv.Quote(name)
+	}
+
+	var expr, message string
+	switch target.Condition {
+	case "notready", "":
+		expr = fmt.Sprintf(`%s == 0`, series("kube_node_status_condition", nodeMatcher, `condition="Ready"`, `status="true"`))
+		message = "node {{ $labels.node }} is not ready"
+	case "mem":
+		expr = fmt.Sprintf(`(1 - %s / %s) * 100 > %d`,
+			series("node_memory_MemAvailable_bytes", nodeMatcher), series("node_memory_MemTotal_bytes", nodeMatcher), target.MemThreshold)
+		message = fmt.Sprintf(`the memory usage of node {{ $labels.node }} is {{ printf "%%.0f" $value }}%%, above %d%%`, target.MemThreshold)
+	case "cpu":
+		expr = fmt.Sprintf(`sum by (node) (rate(%s[5m])) * 100 / count by (node) (%s) > %d`,
+			series("node_cpu_seconds_total", nodeMatcher, `mode!="idle"`), series("node_cpu_seconds_total", nodeMatcher, `mode="idle"`), target.CPUThreshold)
+		message = fmt.Sprintf(`the cpu usage of node {{ $labels.node }} is {{ printf "%%.0f" $value }}%%, above %d%%`, target.CPUThreshold)
+	default:
+		return nil, fmt.Errorf("unsupported node condition [%s]", target.Condition)
+	}
+
+	if len(target.Selector) > 0 {
+		expr = fmt.Sprintf("(%s) * on(node) group_left() %s", expr, series("kube_node_labels", labelMatchers(target.Selector)...))
+	}
+	return &Rule{Expr: expr, Annotations: map[string]string{AnnotationMessage: message}}, nil
+}
+
+func systemServiceRule(target *v3.TargetSystemService) (*Rule, error) {
+	job, ok := systemServiceJobs[target.Condition]
+	if !ok {
+		return nil, fmt.Errorf("unsupported system service [%s]", target.Condition)
+	}
+	return &Rule{
+		Expr:        fmt.Sprintf(`up{job=%q} == 0`, job),
+		Annotations: map[string]string{AnnotationMessage: fmt.Sprintf("system service %s is down", target.Condition)},
+	}, nil
+}
+
+func podRule(target *v3.TargetPod) (*Rule, error) {
+	namespace, name, err := splitID(target.PodName)
+	if err != nil {
+		return nil, err
+	}
+	namespaceMatcher := "namespace=" + strconv.Quote(namespace)
+	podMatcher := "pod=" + strconv.Quote(name)
+
+	var expr, message string
+	switch target.Condition {
+	case "notrunning", "":
+		expr = fmt.Sprintf(`%s == 1`, series("kube_pod_status_phase", namespaceMatcher, podMatcher, `phase=~"Failed|Pending|Unknown"`))
+		message = fmt.Sprintf("pod %s/%s is {{ $labels.phase }}", namespace, name)
+	case "notscheduled":
+		expr = fmt.Sprintf(`%s == 1`, series("kube_pod_status_scheduled", namespaceMatcher, podMatcher, `condition="false"`))
+		message = fmt.Sprintf("pod %s/%s is not scheduled", namespace, name)
+	case "restarts":
+		expr = fmt.Sprintf(`increase(%s[%ds]) >= %d`,
+			series("kube_pod_container_status_restarts_total", namespaceMatcher, podMatcher), target.RestartIntervalSeconds, target.RestartTimes)
+		message = fmt.Sprintf(`container {{ $labels.container }} of pod %s/%s restarted {{ printf "%%.0f" $value }} times in %d seconds`,
+			namespace, name, target.RestartIntervalSeconds)
+	default:
+		return nil, fmt.Errorf("unsupported pod condition [%s]", target.Condition)
+	}
+	// the node label lets node alerts inhibit the alerts of the pods on the node
+	expr = fmt.Sprintf("(%s) * on(namespace, pod) group_left(node) %s", expr, series("kube_pod_info", namespaceMatcher, podMatcher))
+	return &Rule{Expr: expr, Annotations: map[string]string{AnnotationMessage: message}}, nil
+}
+
+// workloadRule fires when the available replicas of a workload fall below the percentage, workloads
+// selected by labels are deployments of the namespaces of project
+func workloadRule(target *v3.TargetWorkload, project string) (*Rule, error) {
+	if target.WorkloadID != "" {
+		kind, namespace, name, err := workloadID(target.WorkloadID)
+		if err != nil {
+			return nil, err
+		}
+		metrics, ok := workloadMetrics[kind]
+		if !ok {
+			return nil, fmt.Errorf("unsupported workload kind [%s]", kind)
+		}
+		namespaceMatcher := "namespace=" + strconv.Quote(namespace)
+		nameMatcher := metrics.label + "=" + strconv.Quote(name)
+		return &Rule{
+			Expr: fmt.Sprintf(`%s / %s * 100 < %d`,
+				series(metrics.available, namespaceMatcher, nameMatcher), series(metrics.desired, namespaceMatcher, nameMatcher), target.AvailablePercentage),
+			Annotations: map[string]string{
+				AnnotationMessage: fmt.Sprintf(`{{ printf "%%.0f" $value }}%% of the replicas of %s %s/%s are available, below %d%%`,
+					kind, namespace, name, target.AvailablePercentage),
+			},
+		}, nil
+	}
+
+	if len(target.Selector) == 0 {
+		return nil, fmt.Errorf("workloadId or selector is required")
+	}
+	metrics := workloadMetrics["deployment"]
+	expr := fmt.Sprintf(`(%s / %s * 100 < %d) * on(namespace, deployment) group_left() %s`,
+		metrics.available, metrics.desired, target.AvailablePercentage, series("kube_deployment_labels", labelMatchers(target.Selector)...))
+	return &Rule{
+		Expr: fmt.Sprintf("(%s) and on(namespace) %s", expr, projectNamespaces(project)),
+		Annotations: map[string]string{
+			AnnotationMessage: fmt.Sprintf(`{{ printf "%%.0f" $value }}%% of the replicas of deployment {{ $labels.namespace }}/{{ $labels.deployment }} are available, below %d%%`,
+				target.AvailablePercentage),
+		},
+	}, nil
+}
+
+// finish sets the name and the labels that identify the alert and its group in alertmanager
+func finish(rule *Rule, namespace, name, alertType, group string, common v3.AlertCommonSpec) {
+	rule.Alert = invalidNameChars.ReplaceAllString(namespace+"_"+name, "_")
+	if rule.Labels == nil {
+		rule.Labels = map[string]string{}
+	}
+	rule.Labels[LabelAlertID] = namespace + ":" + name
+	rule.Labels[LabelAlertType] = alertType
+	rule.Labels[LabelSeverity] = common.Severity
+	if group != "" {
+		rule.Labels[LabelGroupID] = group
+	}
+	if rule.Annotations == nil {
+		rule.Annotations = map[string]string{}
+	}
+	// annotations are templates, the names and descriptions set by users are not
+	rule.Annotations["display_name"] = Escape(common.DisplayName)
+	if description, ok := rule.Annotations["description"]; ok {
+		rule.Annotations["description"] = Escape(description)
+	} else if common.Description != "" {
+		rule.Annotations["description"] = Escape(common.Description)
+	}
+}
+
+// check guards against rendering a rule that prometheus would refuse to load
+func check(rule *Rule) error {
+	if _, err := promql.Check(rule.Expr); err != nil {
+		return fmt.Errorf("invalid expression %q: %v", rule.Expr, err)
+	}
+	return nil
+}
+
+func nodeName(id string, nodes NodeLister) (string, error) {
+	namespace, name, err := splitID(id)
+	if err != nil {
+		return "", err
+	}
+	node, err := nodes.Get(namespace, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get node %s: %v", id, err)
+	}
+	if node.Status.NodeName == "" {
+		return "", fmt.Errorf("node %s has not registered", id)
+	}
+	return node.Status.NodeName, nil
+}
+
+// projectNamespaces selects the namespaces of project by the label rancher puts on them
+func projectNamespaces(project string) string {
+	return series("kube_namespace_labels", projectIDLabel+"="+strconv.Quote(project))
+}
+
+// labelMatchers matches kubernetes labels by the names kube-state-metrics gives them
+func labelMatchers(labels map[string]string) []string {
+	var result []string
+	for key, value := range labels {
+		result = append(result, "label_"+invalidLabelChars.ReplaceAllString(key, "_")+"="+strconv.Quote(value))
+	}
+	sort.Strings(result)
+	return result
+}
+
+// series returns the selector of metric with the matchers that are not empty
+func series(metric string, matchers ...string) string {
+	var result []string
+	for _, matcher := range matchers {
+		if matcher != "" {
+			result = append(result, matcher)
+		}
+	}
+	if len(result) == 0 {
+		return metric
+	}
+	return metric + "{" + strings.Join(result, ",") + "}"
+}
+
+// projectName returns the name of the project from its id, which is prefixed with the cluster
+func projectName(id string) string {
+	parts := strings.SplitN(id, ":", 2)
+	return parts[len(parts)-1]
+}
+
+// workloadID splits ids like deployment:namespace:name
+func workloadID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("invalid workload id [%s]", id)
+	}
+	return strings.ToLower(parts[0]), parts[1], parts[2], nil
+}
+
+// splitID splits ids like namespace:name
+func splitID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid id [%s]", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func sortedGroupNames(groups map[string][]Rule) []string {
+	var result []string
+	for name := range groups {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,38 @@
+package alertrule
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"github.com/rancher/types/internal/golden"
+)
+
+type input struct {
+	ClusterAlerts []*v3.ClusterAlert `json:"clusterAlerts"`
+	ProjectAlerts []*v3.ProjectAlert `json:"projectAlerts"`
+	Nodes         nodeList           `json:"nodes"`
+}
+
+type nodeList []*v3.Node
+
+func (n nodeList) Get(namespace, name string) (*v3.Node, error) {
+	for _, node := range n {
+		if node.Namespace == namespace && node.Name == name {
+			return node, nil
+		}
+	}
+	return nil, fmt.Errorf("node %s not found", name)
+}
+
+func TestBuild(t *testing.T) {
+	golden.Run(t, func() interface{} { return &input{} }, func(i interface{}) (string, error) {
+		in := i.(*input)
+		file, err := Build(in.ClusterAlerts, in.ProjectAlerts, in.Nodes)
+		if err != nil {
+			return "", err
+		}
+		data, err := Render(file)
+		return string(data), err
+	})
+}
@@ -3,6 +3,7 @@ package schema
 import (
 	"github.com/rancher/norman/types"
 	m "github.com/rancher/norman/types/mapper"
+	"github.com/rancher/types/apis/management.cattle.io/v3"
 	"github.com/rancher/types/factory"
 	"k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
@@ -31,11 +32,17 @@ func namespaceTypes(schemas *types.Schemas) *types.Schemas {
 		AddMapperForType(&Version, v1.Namespace{},
 			&m.AnnotationField{Field: "description"},
 			&m.AnnotationField{Field: "projectId"},
+			&m.AnnotationField{Field: "resourceQuota", Object: true},
+			&m.AnnotationField{Field: "containerDefaultResourceLimit", Object: true},
 			&m.Drop{Field: "status"},
 		).
 		MustImport(&Version, v1.Namespace{}, struct {
 			Description string `json:"description"`
 			ProjectID   string `norman:"type=reference[/v3/schemas/project]"`
+			// ResourceQuota overrides the namespace default quota of the project
+			ResourceQuota *v3.NamespaceResourceQuota `json:"resourceQuota,omitempty"`
+			// ContainerDefaultResourceLimit overrides the container default resource limit of the project
+			ContainerDefaultResourceLimit *v3.ContainerResourceLimit `json:"containerDefaultResourceLimit,omitempty"`
 		}{})
 }
 
@@ -26,6 +26,8 @@ type Interface interface {
 	ConfigMapsGetter
 	ServiceAccountsGetter
 	ReplicationControllersGetter
+	ResourceQuotasGetter
+	LimitRangesGetter
 }
 
 type Client struct {
@@ -44,6 +46,8 @@ type Client struct {
 	configMapControllers             map[string]ConfigMapController
 	serviceAccountControllers        map[string]ServiceAccountController
 	replicationControllerControllers map[string]ReplicationControllerController
+	resourceQuotaControllers         map[string]ResourceQuotaController
+	limitRangeControllers            map[string]LimitRangeController
 }
 
 func NewForConfig(config rest.Config) (Interface, error) {
@@ -71,6 +75,8 @@ func NewForConfig(config rest.Config) (Interface, error) {
 		configMapControllers:             map[string]ConfigMapController{},
 		serviceAccountControllers:        map[string]ServiceAccountController{},
 		replicationControllerControllers: map[string]ReplicationControllerController{},
+		resourceQuotaControllers:         map[string]ResourceQuotaController{},
+		limitRangeControllers:            map[string]LimitRangeController{},
 	}, nil
 }
 
@@ -228,3 +234,29 @@ func (c *Client) ReplicationControllers(namespace string) ReplicationControllerI
 		objectClient: objectClient,
 	}
 }
+
+type ResourceQuotasGetter interface {
+	ResourceQuotas(namespace string) ResourceQuotaInterface
+}
+
+func (c *Client) ResourceQuotas(namespace string) ResourceQuotaInterface {
+	objectClient := objectclient.NewObjectClient(namespace, c.restClient, &ResourceQuotaResource, ResourceQuotaGroupVersionKind, resourceQuotaFactory{})
+	return &resourceQuotaClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
+type LimitRangesGetter interface {
+	LimitRanges(namespace string) LimitRangeInterface
+}
+
+func (c *Client) LimitRanges(namespace string) LimitRangeInterface {
+	objectClient := objectclient.NewObjectClient(namespace, c.restClient, &LimitRangeResource, LimitRangeGroupVersionKind, limitRangeFactory{})
+	return &limitRangeClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
@@ -0,0 +1,253 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	LimitRangeGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "LimitRange",
+	}
+	LimitRangeResource = metav1.APIResource{
+		Name:         "limitranges",
+		SingularName: "limitrange",
+		Namespaced:   true,
+
+		Kind: LimitRangeGroupVersionKind.Kind,
+	}
+)
+
+type LimitRangeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []v1.LimitRange
+}
+
+type LimitRangeHandlerFunc func(key string, obj *v1.LimitRange) error
+
+type LimitRangeLister interface {
+	List(namespace string, selector labels.Selector) (ret []*v1.LimitRange, err error)
+	Get(namespace, name string) (*v1.LimitRange, error)
+}
+
+type LimitRangeController interface {
+	Informer() cache.SharedIndexInformer
+	Lister() LimitRangeLister
+	AddHandler(name string, handler LimitRangeHandlerFunc)
+	AddClusterScopedHandler(name, clusterName string, handler LimitRangeHandlerFunc)
+	Enqueue(namespace, name string)
+	Sync(ctx context.Context) error
+	Start(ctx context.Context, threadiness int) error
+}
+
+type LimitRangeInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*v1.LimitRange) (*v1.LimitRange, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.LimitRange, error)
+	Get(name string, opts metav1.GetOptions) (*v1.LimitRange, error)
+	Update(*v1.LimitRange) (*v1.LimitRange, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*LimitRangeList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() LimitRangeController
+	AddHandler(name string, sync LimitRangeHandlerFunc)
+	AddLifecycle(name string, lifecycle LimitRangeLifecycle)
+	AddClusterScopedHandler(name, clusterName string, sync LimitRangeHandlerFunc)
+	AddClusterScopedLifecycle(name, clusterName string, lifecycle LimitRangeLifecycle)
+}
+
+type limitRangeLister struct {
+	controller *limitRangeController
+}
+
+func (l *limitRangeLister) List(namespace string, selector labels.Selector) (ret []*v1.LimitRange, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.LimitRange))
+	})
+	return
+}
+
+func (l *limitRangeLister) Get(namespace, name string) (*v1.LimitRange, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    LimitRangeGroupVersionKind.Group,
+			Resource: "limitRange",
+		}, name)
+	}
+	return obj.(*v1.LimitRange), nil
+}
+
+type limitRangeController struct {
+	controller.GenericController
+}
+
+func (c *limitRangeController) Lister() LimitRangeLister {
+	return &limitRangeLister{
+		controller: c,
+	}
+}
+
+func (c *limitRangeController) AddHandler(name string, handler LimitRangeHandlerFunc) {
+	c.GenericController.AddHandler(name, func(key string) error {
+		obj, exists, err := c.Informer().GetStore().GetByKey(key)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return handler(key, nil)
+		}
+		return handler(key, obj.(*v1.LimitRange))
+	})
+}
+
+func (c *limitRangeController) AddClusterScopedHandler(name, cluster string, handler LimitRangeHandlerFunc) {
+	c.GenericController.AddHandler(name, func(key string) error {
+		obj, exists, err := c.Informer().GetStore().GetByKey(key)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return handler(key, nil)
+		}
+
+		if !controller.ObjectInCluster(cluster, obj) {
+			return nil
+		}
+
+		return handler(key, obj.(*v1.LimitRange))
+	})
+}
+
+type limitRangeFactory struct {
+}
+
+func (c limitRangeFactory) Object() runtime.Object {
+	return &v1.LimitRange{}
+}
+
+func (c limitRangeFactory) List() runtime.Object {
+	return &LimitRangeList{}
+}
+
+func (s *limitRangeClient) Controller() LimitRangeController {
+	s.client.Lock()
+	defer s.client.Unlock()
+
+	c, ok := s.client.limitRangeControllers[s.ns]
+	if ok {
+		return c
+	}
+
+	genericController := controller.NewGenericController(LimitRangeGroupVersionKind.Kind+"Controller",
+		s.objectClient)
+
+	c = &limitRangeController{
+		GenericController: genericController,
+	}
+
+	s.client.limitRangeControllers[s.ns] = c
+	s.client.starters = append(s.client.starters, c)
+
+	return c
+}
+
+type limitRangeClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   LimitRangeController
+}
+
+func (s *limitRangeClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *limitRangeClient) Create(o *v1.LimitRange) (*v1.LimitRange, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*v1.LimitRange), err
+}
+
+func (s *limitRangeClient) Get(name string, opts metav1.GetOptions) (*v1.LimitRange, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*v1.LimitRange), err
+}
+
+func (s *limitRangeClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.LimitRange, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*v1.LimitRange), err
+}
+
+func (s *limitRangeClient) Update(o *v1.LimitRange) (*v1.LimitRange, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*v1.LimitRange), err
+}
+
+func (s *limitRangeClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *limitRangeClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *limitRangeClient) List(opts metav1.ListOptions) (*LimitRangeList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*LimitRangeList), err
+}
+
+func (s *limitRangeClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *limitRangeClient) Patch(o *v1.LimitRange, data []byte, subresources ...string) (*v1.LimitRange, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, data, subresources...)
+	return obj.(*v1.LimitRange), err
+}
+
+func (s *limitRangeClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *limitRangeClient) AddHandler(name string, sync LimitRangeHandlerFunc) {
+	s.Controller().AddHandler(name, sync)
+}
+
+func (s *limitRangeClient) AddLifecycle(name string, lifecycle LimitRangeLifecycle) {
+	sync := NewLimitRangeLifecycleAdapter(name, false, s, lifecycle)
+	s.AddHandler(name, sync)
+}
+
+func (s *limitRangeClient) AddClusterScopedHandler(name, clusterName string, sync LimitRangeHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(name, clusterName, sync)
+}
+
+func (s *limitRangeClient) AddClusterScopedLifecycle(name, clusterName string, lifecycle LimitRangeLifecycle) {
+	sync := NewLimitRangeLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.AddClusterScopedHandler(name, clusterName, sync)
+}
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type LimitRangeLifecycle interface {
+	Create(obj *v1.LimitRange) (*v1.LimitRange, error)
+	Remove(obj *v1.LimitRange) (*v1.LimitRange, error)
+	Updated(obj *v1.LimitRange) (*v1.LimitRange, error)
+}
+
+type limitRangeLifecycleAdapter struct {
+	lifecycle LimitRangeLifecycle
+}
+
+func (w *limitRangeLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.LimitRange))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *limitRangeLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.LimitRange))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *limitRangeLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.LimitRange))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewLimitRangeLifecycleAdapter(name string, clusterScoped bool, client LimitRangeInterface, l LimitRangeLifecycle) LimitRangeHandlerFunc {
+	adapter := &limitRangeLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *v1.LimitRange) error {
+		if obj == nil {
+			return syncFn(key, nil)
+		}
+		return syncFn(key, obj)
+	}
+}
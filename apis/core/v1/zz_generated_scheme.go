@@ -44,6 +44,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ConfigMapList{},
 		&ServiceAccountList{},
 		&ReplicationControllerList{},
+		&ResourceQuotaList{},
+		&LimitRangeList{},
 	)
 	return nil
 }
@@ -0,0 +1,48 @@
+package v3
+
+// DefaultAKSAgentPoolName is the name of the agent pool described by the flat fields of
+// AzureKubernetesServiceConfig when AgentPoolName is empty
+const DefaultAKSAgentPoolName = "agentpool"
+
+// hasFlatAgentPool reports whether any of the fields that describe the default agent pool are set
+func (a *AzureKubernetesServiceConfig) hasFlatAgentPool() bool {
+	return a.Count != 0 || a.AgentPoolName != "" || a.OsDiskSizeGB != 0 || a.AgentVMSize != ""
+}
+
+// EffectiveAgentPools returns the agent pools of the cluster. A config written before agent pools were
+// supported describes a single pool through Count, AgentPoolName, OsDiskSizeGB and AgentVMSize, the
+// defaults of which apply when none of them are set.
+func (a *AzureKubernetesServiceConfig) EffectiveAgentPools() []AKSAgentPool {
+	if len(a.AgentPools) > 0 {
+		return a.AgentPools
+	}
+
+	pool := AKSAgentPool{
+		Name:         a.AgentPoolName,
+		Count:        a.Count,
+		VMSize:       a.AgentVMSize,
+		OsDiskSizeGB: a.OsDiskSizeGB,
+	}
+	if pool.Name == "" {
+		pool.Name = DefaultAKSAgentPoolName
+	}
+	if pool.Count == 0 {
+		pool.Count = 1
+	}
+	return []AKSAgentPool{pool}
+}
+
+// MigrateAgentPools moves the default agent pool described by the flat fields into AgentPools and clears
+// the flat fields. It returns false if there was nothing to migrate.
+func (a *AzureKubernetesServiceConfig) MigrateAgentPools() bool {
+	if len(a.AgentPools) > 0 || !a.hasFlatAgentPool() {
+		return false
+	}
+
+	a.AgentPools = a.EffectiveAgentPools()
+	a.Count = 0
+	a.AgentPoolName = ""
+	a.OsDiskSizeGB = 0
+	a.AgentVMSize = ""
+	return true
+}
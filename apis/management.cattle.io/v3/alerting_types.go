@@ -1,10 +1,18 @@
 package v3
 
 import (
+	"github.com/rancher/norman/condition"
 	"github.com/rancher/norman/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+var (
+	// NotifierConditionReachable is false when the last notification could not be delivered to the service
+	NotifierConditionReachable condition.Cond = "Reachable"
+	// NotifierConditionAuthenticated is false when the service rejected the credentials of the notifier
+	NotifierConditionAuthenticated condition.Cond = "Authenticated"
+)
+
 type ClusterAlert struct {
 	types.Namespaced
 
@@ -40,6 +48,78 @@ type AlertCommonSpec struct {
 	Recipients            []Recipient `json:"recipients,omitempty" norman:"required"`
 	InitialWaitSeconds    int         `json:"initialWaitSeconds,omitempty" norman:"required,default=180,min=0"`
 	RepeatIntervalSeconds int         `json:"repeatIntervalSeconds,omitempty"  norman:"required,default=3600,min=0"`
+	// InhibitRules suppress other alerts while this alert fires
+	InhibitRules []InhibitRule `json:"inhibitRules,omitempty"`
+	// SilenceWindows are the times at which the alert is not sent
+	SilenceWindows []SilenceWindow `json:"silenceWindows,omitempty"`
+	// MessageTemplate is a go template of the notifications of the alert, it replaces the template of the
+	// notifier
+	MessageTemplate string `json:"messageTemplate,omitempty"`
+}
+
+type ClusterAlertGroup struct {
+	types.Namespaced
+
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterAlertGroupSpec `json:"spec"`
+}
+
+type ProjectAlertGroup struct {
+	types.Namespaced
+
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ProjectAlertGroupSpec `json:"spec"`
+}
+
+// AlertGroupSpec controls how the alerts of a group are notified, the timing of the group replaces the
+// timing of its alerts
+type AlertGroupSpec struct {
+	DisplayName string `json:"displayName,omitempty" norman:"required"`
+	Description string `json:"description,omitempty"`
+	// GroupBy are the labels by which the firing alerts of the group are batched into one notification
+	GroupBy               []string `json:"groupBy,omitempty"`
+	GroupWaitSeconds      int      `json:"groupWaitSeconds,omitempty" norman:"required,default=30,min=1"`
+	GroupIntervalSeconds  int      `json:"groupIntervalSeconds,omitempty" norman:"required,default=180,min=1"`
+	RepeatIntervalSeconds int      `json:"repeatIntervalSeconds,omitempty" norman:"required,default=3600,min=1"`
+}
+
+type ClusterAlertGroupSpec struct {
+	AlertGroupSpec
+
+	ClusterName string `json:"clusterName" norman:"type=reference[cluster]"`
+}
+
+type ProjectAlertGroupSpec struct {
+	AlertGroupSpec
+
+	ProjectName string `json:"projectName" norman:"type=reference[project]"`
+}
+
+// InhibitRule suppresses the alerts that match TargetMatchers while the alert that holds the rule fires,
+// for example pod alerts on a node that is not ready
+type InhibitRule struct {
+	// TargetMatchers are labels of the suppressed alerts such as alert_type
+	TargetMatchers map[string]string `json:"targetMatchers,omitempty" norman:"required"`
+	// Equal are the labels that must have the same value in both alerts, such as node
+	Equal []string `json:"equal,omitempty"`
+}
+
+type SilenceWindow struct {
+	// Start and End are RFC 3339 times, recurring windows repeat in the time zone offset of Start
+	Start      string `json:"start,omitempty" norman:"required"`
+	End        string `json:"end,omitempty" norman:"required"`
+	Recurrence string `json:"recurrence,omitempty" norman:"type=enum,options=none|daily|weekly|monthly,default=none"`
+	// Until is the RFC 3339 time after which a recurring window does not start again
+	Until   string `json:"until,omitempty"`
+	Comment string `json:"comment,omitempty"`
 }
 
 type ClusterAlertSpec struct {
@@ -49,6 +129,8 @@ type ClusterAlertSpec struct {
 	TargetNode          *TargetNode          `json:"targetNode,omitempty"`
 	TargetSystemService *TargetSystemService `json:"targetSystemService,omitempty"`
 	TargetEvent         *TargetEvent         `json:"targetEvent,omitempty"`
+	TargetMetric        *MetricRule          `json:"targetMetric,omitempty"`
+	GroupName           string               `json:"groupName,omitempty" norman:"type=reference[clusterAlertGroup]"`
 }
 
 type ProjectAlertSpec struct {
@@ -57,12 +139,14 @@ type ProjectAlertSpec struct {
 	ProjectName    string          `json:"projectName" norman:"type=reference[project]"`
 	TargetWorkload *TargetWorkload `json:"targetWorkload,omitempty"`
 	TargetPod      *TargetPod      `json:"targetPod,omitempty"`
+	TargetMetric   *MetricRule     `json:"targetMetric,omitempty"`
+	GroupName      string          `json:"groupName,omitempty" norman:"type=reference[projectAlertGroup]"`
 }
 
 type Recipient struct {
 	Recipient    string `json:"recipient,omitempty"`
 	NotifierName string `json:"notifierName,omitempty" norman:"required,type=reference[notifier]"`
-	NotifierType string `json:"notifierType,omitempty" norman:"required,options=slack|email|pagerduty|webhook"`
+	NotifierType string `json:"notifierType,omitempty" norman:"required,options=slack|email|pagerduty|webhook|opsgenie|msteams"`
 }
 
 type TargetNode struct {
@@ -95,8 +179,28 @@ type TargetSystemService struct {
 	Condition string `json:"condition,omitempty" norman:"required,options=etcd|controller-manager|scheduler,default=scheduler"`
 }
 
+// MetricRule fires when the result of Expression compared with ThresholdValue holds for Duration
+type MetricRule struct {
+	// Expression is a prometheus query that returns an instant vector
+	Expression     string  `json:"expression,omitempty" norman:"required"`
+	Description    string  `json:"description,omitempty"`
+	Duration       string  `json:"duration,omitempty" norman:"required,default=1m"`
+	Comparison     string  `json:"comparison,omitempty" norman:"type=enum,options=equal|not-equal|greater-than|less-than|greater-or-equal|less-or-equal,default=equal"`
+	ThresholdValue float64 `json:"thresholdValue,omitempty" norman:"type=float"`
+}
+
 type AlertStatus struct {
 	AlertState string `json:"alertState,omitempty" norman:"options=active|inactive|alerting|muted,default=active"`
+	// Suppression is set while notifications of the alert are held back
+	Suppression *AlertSuppression `json:"suppression,omitempty" norman:"nocreate,noupdate"`
+}
+
+type AlertSuppression struct {
+	Reason string `json:"reason,omitempty" norman:"options=muted|silenced|inhibited"`
+	// Message names the silence window or the inhibiting alerts
+	Message string `json:"message,omitempty"`
+	// Until is the RFC 3339 time the suppression ends, empty when it has no fixed end
+	Until string `json:"until,omitempty"`
 }
 
 type Notifier struct {
@@ -122,6 +226,12 @@ type NotifierSpec struct {
 	SlackConfig     *SlackConfig     `json:"slackConfig,omitempty"`
 	PagerdutyConfig *PagerdutyConfig `json:"pagerdutyConfig,omitempty"`
 	WebhookConfig   *WebhookConfig   `json:"webhookConfig,omitempty"`
+	OpsgenieConfig  *OpsgenieConfig  `json:"opsgenieConfig,omitempty"`
+	MSTeamsConfig   *MSTeamsConfig   `json:"msteamsConfig,omitempty"`
+	// MessageTemplate is a go template of the notifications sent by the notifier, it replaces the default
+	// template of the notifier type. Webhooks without headers or a body template are sent the alerts in
+	// the format of the alert manager instead.
+	MessageTemplate string `json:"messageTemplate,omitempty"`
 }
 
 type Notification struct {
@@ -130,6 +240,17 @@ type Notification struct {
 	SlackConfig     *SlackConfig     `json:"slackConfig,omitempty"`
 	PagerdutyConfig *PagerdutyConfig `json:"pagerdutyConfig,omitempty"`
 	WebhookConfig   *WebhookConfig   `json:"webhookConfig,omitempty"`
+	OpsgenieConfig  *OpsgenieConfig  `json:"opsgenieConfig,omitempty"`
+	MSTeamsConfig   *MSTeamsConfig   `json:"msteamsConfig,omitempty"`
+}
+
+type MessageTemplatePreviewInput struct {
+	NotifierType    string `json:"notifierType,omitempty" norman:"type=enum,options=slack|email|pagerduty|webhook|opsgenie|msteams"`
+	MessageTemplate string `json:"messageTemplate,omitempty"`
+}
+
+type MessageTemplatePreviewOutput struct {
+	Message string `json:"message,omitempty"`
 }
 
 type SMTPConfig struct {
@@ -153,9 +274,63 @@ type PagerdutyConfig struct {
 
 type WebhookConfig struct {
 	URL string `json:"url,omitempty" norman:"required"`
+	// Headers are added to every request, they must not set Authorization when an auth option is set
+	Headers     map[string]string `json:"headers,omitempty"`
+	BasicAuth   *HTTPBasicAuth    `json:"basicAuth,omitempty"`
+	BearerToken string            `json:"bearerToken,omitempty" norman:"type=password"`
+	TLSConfig   *HTTPTLSConfig    `json:"tlsConfig,omitempty"`
+	// BodyTemplate is a go template of the request body, the body is a JSON document with the message
+	// when it is empty
+	BodyTemplate string `json:"bodyTemplate,omitempty"`
+}
+
+type HTTPBasicAuth struct {
+	Username string `json:"username,omitempty" norman:"required"`
+	Password string `json:"password,omitempty" norman:"type=password"`
+}
+
+type HTTPTLSConfig struct {
+	// CACert is the PEM encoded CA that signs the certificate of the server, the system CAs are used when
+	// it is empty
+	CACert     string `json:"caCert,omitempty"`
+	ClientCert string `json:"clientCert,omitempty"`
+	ClientKey  string `json:"clientKey,omitempty" norman:"type=password"`
+	ServerName string `json:"serverName,omitempty"`
+	// InsecureSkipVerify accepts any certificate of the server
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+type OpsgenieConfig struct {
+	APIKey string `json:"apiKey,omitempty" norman:"required,type=password"`
+	// APIURL is the address of the opsgenie API, https://api.eu.opsgenie.com/ for accounts in the EU
+	APIURL string `json:"apiUrl,omitempty" norman:"default=https://api.opsgenie.com/"`
+	// DefaultRecipient is the team the alerts are assigned to
+	DefaultRecipient string   `json:"defaultRecipient,omitempty"`
+	Tags             []string `json:"tags,omitempty"`
+}
+
+type MSTeamsConfig struct {
+	// URL is the incoming webhook of the channel
+	URL string `json:"url,omitempty" norman:"required"`
 }
 
 type NotifierStatus struct {
+	Conditions []Condition `json:"conditions,omitempty"`
+	// LastSend is the most recent attempt to send a notification
+	LastSend *NotifierSendAttempt `json:"lastSend,omitempty"`
+	// SendHistory holds the most recent attempts, newest first
+	SendHistory []NotifierSendAttempt `json:"sendHistory,omitempty"`
+}
+
+type NotifierSendAttempt struct {
+	// Time is the RFC3339 time of the attempt
+	Time string `json:"time,omitempty"`
+	// Source is the alert the notification was sent for, empty for the send action
+	Source  string `json:"source,omitempty"`
+	Success bool   `json:"success,omitempty"`
+	// ResponseCode is the HTTP or SMTP status of the response, 0 when no response was received
+	ResponseCode int    `json:"responseCode,omitempty"`
+	Error        string `json:"error,omitempty"`
 }
 
 type AlertSystemImages struct {
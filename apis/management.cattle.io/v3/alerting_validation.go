@@ -0,0 +1,229 @@
+package v3
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/rancher/types/promql"
+)
+
+// MetricRuleComparisons maps the values of MetricRule.Comparison to the prometheus operator
+var MetricRuleComparisons = map[string]string{
+	"equal":            "==",
+	"not-equal":        "!=",
+	"greater-than":     ">",
+	"less-than":        "<",
+	"greater-or-equal": ">=",
+	"less-or-equal":    "<=",
+}
+
+// Validate checks the syntax of the expression and the duration of the rule, so that a mistake is not only
+// found when prometheus loads the rule
+func (m *MetricRule) Validate() ValidationResult {
+	result := ValidationResult{}
+
+	if typ, err := promql.Check(m.Expression); err != nil {
+		result.errorf("expression", "invalid expression: %v", err)
+	} else if typ != promql.ValueTypeVector {
+		result.errorf("expression", "must return an instant vector, got %s", typ)
+	}
+	if !prometheusDurationRegexp.MatchString(m.Duration) {
+		result.errorf("duration", "[%s] is not a valid duration, use a number followed by one of ms, s, m, h, d, w, y", m.Duration)
+	}
+	if _, ok := MetricRuleComparisons[m.Comparison]; m.Comparison != "" && !ok {
+		result.errorf("comparison", "unsupported comparison [%s]", m.Comparison)
+	}
+
+	return result
+}
+
+// silenceWindowPeriods are the shortest periods of the recurrences of silence windows, a window must be
+// shorter so that its occurrences do not overlap
+var silenceWindowPeriods = map[string]time.Duration{
+	"":        0,
+	"none":    0,
+	"daily":   24 * time.Hour,
+	"weekly":  7 * 24 * time.Hour,
+	"monthly": 28 * 24 * time.Hour,
+}
+
+var (
+	alertLabelRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	httpHeaderRegexp = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+)
+
+// Validate checks the inhibit rules and silence windows of the alert
+func (a *AlertCommonSpec) Validate() ValidationResult {
+	result := ValidationResult{}
+
+	for i, rule := range a.InhibitRules {
+		field := fmt.Sprintf("inhibitRules[%d]", i)
+		if len(rule.TargetMatchers) == 0 {
+			result.errorf(field+".targetMatchers", "at least one matcher is required")
+		}
+		for _, label := range sortedKeys(rule.TargetMatchers) {
+			validateAlertLabel(field+".targetMatchers", label, &result)
+		}
+		for _, label := range rule.Equal {
+			validateAlertLabel(field+".equal", label, &result)
+		}
+	}
+
+	for i, window := range a.SilenceWindows {
+		validateSilenceWindow(fmt.Sprintf("silenceWindows[%d]", i), window, &result)
+	}
+
+	return result
+}
+
+// Validate checks the labels and timing of the group
+func (g *AlertGroupSpec) Validate() ValidationResult {
+	result := ValidationResult{}
+
+	for _, label := range g.GroupBy {
+		validateAlertLabel("groupBy", label, &result)
+	}
+	if g.GroupIntervalSeconds > 0 && g.RepeatIntervalSeconds > 0 && g.RepeatIntervalSeconds < g.GroupIntervalSeconds {
+		result.warnf("repeatIntervalSeconds", "notifications are not repeated more often than groupIntervalSeconds [%d]", g.GroupIntervalSeconds)
+	}
+
+	return result
+}
+
+func validateSilenceWindow(field string, window SilenceWindow, result *ValidationResult) {
+	start, err := time.Parse(time.RFC3339, window.Start)
+	if err != nil {
+		result.errorf(field+".start", "[%s] is not an RFC 3339 time", window.Start)
+	}
+	end, endErr := time.Parse(time.RFC3339, window.End)
+	if endErr != nil {
+		result.errorf(field+".end", "[%s] is not an RFC 3339 time", window.End)
+	}
+	if err != nil || endErr != nil {
+		return
+	}
+
+	if !end.After(start) {
+		result.errorf(field+".end", "must be after start")
+	}
+	period, ok := silenceWindowPeriods[window.Recurrence]
+	if !ok {
+		result.errorf(field+".recurrence", "unsupported recurrence [%s]", window.Recurrence)
+	} else if period > 0 && end.Sub(start) >= period {
+		result.errorf(field+".end", "a %s window must end before its next occurrence starts", window.Recurrence)
+	}
+
+	if window.Until != "" {
+		until, err := time.Parse(time.RFC3339, window.Until)
+		if err != nil {
+			result.errorf(field+".until", "[%s] is not an RFC 3339 time", window.Until)
+		} else if until.Before(start) {
+			result.errorf(field+".until", "must not be before start")
+		}
+		if period == 0 {
+			result.warnf(field+".until", "is ignored for windows that do not recur")
+		}
+	}
+}
+
+func validateAlertLabel(field, label string, result *ValidationResult) {
+	if !alertLabelRegexp.MatchString(label) {
+		result.errorf(field, "[%s] is not a valid label name", label)
+	}
+}
+
+// Validate checks that exactly one notifier config is set and that it can be sent to
+func (n *NotifierSpec) Validate() ValidationResult {
+	result := ValidationResult{}
+
+	var set []string
+	if n.SMTPConfig != nil {
+		set = append(set, "smtpConfig")
+	}
+	if n.SlackConfig != nil {
+		set = append(set, "slackConfig")
+		validateURL("slackConfig.url", n.SlackConfig.URL, &result)
+	}
+	if n.PagerdutyConfig != nil {
+		set = append(set, "pagerdutyConfig")
+	}
+	if n.WebhookConfig != nil {
+		set = append(set, "webhookConfig")
+		validateWebhook(n.WebhookConfig, &result)
+		if n.MessageTemplate != "" && len(n.WebhookConfig.Headers) == 0 && n.WebhookConfig.BodyTemplate == "" {
+			result.warnf("messageTemplate", "alerts are posted in the format of the alert manager to webhooks without headers or a body template, the template only applies to test notifications")
+		}
+	}
+	if n.OpsgenieConfig != nil {
+		set = append(set, "opsgenieConfig")
+		if n.OpsgenieConfig.APIURL != "" {
+			validateURL("opsgenieConfig.apiUrl", n.OpsgenieConfig.APIURL, &result)
+		}
+	}
+	if n.MSTeamsConfig != nil {
+		set = append(set, "msteamsConfig")
+		validateURL("msteamsConfig.url", n.MSTeamsConfig.URL, &result)
+	}
+
+	switch len(set) {
+	case 0:
+		result.errorf("", "one notifier config is required")
+	case 1:
+	default:
+		result.errorf(set[1], "only one notifier config can be set, found %s", strings.Join(set, ", "))
+	}
+
+	return result
+}
+
+func validateWebhook(webhook *WebhookConfig, result *ValidationResult) {
+	validateURL("webhookConfig.url", webhook.URL, result)
+
+	auth := webhook.BasicAuth != nil || webhook.BearerToken != ""
+	if webhook.BasicAuth != nil && webhook.BearerToken != "" {
+		result.errorf("webhookConfig.bearerToken", "basicAuth and bearerToken can not both be set")
+	}
+	for _, name := range sortedKeys(webhook.Headers) {
+		if !httpHeaderRegexp.MatchString(name) {
+			result.errorf("webhookConfig.headers", "[%s] is not a valid header name", name)
+		}
+		if auth && strings.EqualFold(name, "Authorization") {
+			result.errorf("webhookConfig.headers", "the Authorization header is set by basicAuth or bearerToken")
+		}
+	}
+
+	if webhook.BodyTemplate != "" {
+		if _, err := template.New("body").Parse(webhook.BodyTemplate); err != nil {
+			result.errorf("webhookConfig.bodyTemplate", "invalid template: %v", err)
+		}
+	}
+
+	if tlsConfig := webhook.TLSConfig; tlsConfig != nil {
+		if tlsConfig.CACert != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(tlsConfig.CACert)) {
+			result.errorf("webhookConfig.tlsConfig.caCert", "no PEM encoded certificate found")
+		}
+		if (tlsConfig.ClientCert == "") != (tlsConfig.ClientKey == "") {
+			result.errorf("webhookConfig.tlsConfig.clientCert", "clientCert and clientKey must be set together")
+		} else if tlsConfig.ClientCert != "" {
+			if _, err := tls.X509KeyPair([]byte(tlsConfig.ClientCert), []byte(tlsConfig.ClientKey)); err != nil {
+				result.errorf("webhookConfig.tlsConfig.clientCert", "invalid client certificate: %v", err)
+			}
+		}
+		if tlsConfig.InsecureSkipVerify {
+			result.warnf("webhookConfig.tlsConfig.insecureSkipVerify", "the certificate of the server is not verified")
+		}
+	}
+}
+
+func validateURL(field, value string, result *ValidationResult) {
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		result.errorf(field, "[%s] is not a valid http or https URL", value)
+	}
+}
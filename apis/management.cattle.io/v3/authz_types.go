@@ -28,6 +28,8 @@ type Project struct {
 type ProjectStatus struct {
 	Conditions                    []ProjectCondition `json:"conditions"`
 	PodSecurityPolicyTemplateName string             `json:"podSecurityPolicyTemplateId"`
+	// ResourceQuotaUsage is the project limit and the part of it that is handed out to namespaces
+	ResourceQuotaUsage *ProjectResourceQuotaUsage `json:"resourceQuotaUsage,omitempty" norman:"nocreate,noupdate"`
 }
 
 type ProjectCondition struct {
@@ -49,6 +51,59 @@ type ProjectSpec struct {
 	DisplayName string `json:"displayName,omitempty" norman:"required"`
 	Description string `json:"description"`
 	ClusterName string `json:"clusterName,omitempty" norman:"required,type=reference[cluster]"`
+	// ResourceQuota caps the sum of the quotas of all namespaces in the project
+	ResourceQuota *ProjectResourceQuota `json:"resourceQuota,omitempty"`
+	// NamespaceDefaultResourceQuota is the quota of namespaces in the project that do not override it,
+	// required when ResourceQuota is set
+	NamespaceDefaultResourceQuota *NamespaceResourceQuota `json:"namespaceDefaultResourceQuota,omitempty"`
+	// ContainerDefaultResourceLimit is given to containers of the project that do not set their own
+	// requests and limits
+	ContainerDefaultResourceLimit *ContainerResourceLimit `json:"containerDefaultResourceLimit,omitempty"`
+	// EnableProjectMonitoring deploys a prometheus that scrapes the workloads of the project
+	EnableProjectMonitoring bool              `json:"enableProjectMonitoring,omitempty"`
+	MonitoringConfig        *MonitoringConfig `json:"monitoringConfig,omitempty"`
+}
+
+type ProjectResourceQuota struct {
+	Limit ResourceQuotaLimit `json:"limit,omitempty"`
+}
+
+type NamespaceResourceQuota struct {
+	Limit ResourceQuotaLimit `json:"limit,omitempty"`
+}
+
+// ContainerResourceLimit holds quantities in the format of the kubernetes container resources, empty
+// fields have no default
+type ContainerResourceLimit struct {
+	RequestsCPU    string `json:"requestsCpu,omitempty"`
+	RequestsMemory string `json:"requestsMemory,omitempty"`
+	LimitsCPU      string `json:"limitsCpu,omitempty"`
+	LimitsMemory   string `json:"limitsMemory,omitempty"`
+}
+
+type ProjectResourceQuotaUsage struct {
+	// Limit is the project limit in effect
+	Limit ResourceQuotaLimit `json:"limit,omitempty"`
+	// UsedLimit is the sum of the quotas of the namespaces in the project
+	UsedLimit ResourceQuotaLimit `json:"usedLimit,omitempty"`
+}
+
+// ResourceQuotaLimit holds quantities in the format of the kubernetes resource quota, empty fields are
+// not limited
+type ResourceQuotaLimit struct {
+	Pods                   string `json:"pods,omitempty"`
+	Services               string `json:"services,omitempty"`
+	ReplicationControllers string `json:"replicationControllers,omitempty"`
+	Secrets                string `json:"secrets,omitempty"`
+	ConfigMaps             string `json:"configMaps,omitempty"`
+	PersistentVolumeClaims string `json:"persistentVolumeClaims,omitempty"`
+	ServicesNodePorts      string `json:"servicesNodePorts,omitempty"`
+	ServicesLoadBalancers  string `json:"servicesLoadBalancers,omitempty"`
+	RequestsCPU            string `json:"requestsCpu,omitempty"`
+	RequestsMemory         string `json:"requestsMemory,omitempty"`
+	RequestsStorage        string `json:"requestsStorage,omitempty"`
+	LimitsCPU              string `json:"limitsCpu,omitempty"`
+	LimitsMemory           string `json:"limitsMemory,omitempty"`
 }
 
 type GlobalRole struct {
@@ -0,0 +1,41 @@
+package v3
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Validate checks that the quantities of the limit parse and that no request is larger than the limit of
+// the same resource
+func (c *ContainerResourceLimit) Validate() ValidationResult {
+	result := ValidationResult{}
+
+	quantities := map[string]resource.Quantity{}
+	for _, r := range []struct {
+		name, value string
+	}{
+		{"requestsCpu", c.RequestsCPU},
+		{"requestsMemory", c.RequestsMemory},
+		{"limitsCpu", c.LimitsCPU},
+		{"limitsMemory", c.LimitsMemory},
+	} {
+		if r.value == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(r.value)
+		if err != nil || quantity.Sign() < 0 {
+			result.errorf(r.name, "[%s] is not a valid quantity", r.value)
+			continue
+		}
+		quantities[r.name] = quantity
+	}
+
+	for _, name := range []string{"Cpu", "Memory"} {
+		request, hasRequest := quantities["requests"+name]
+		limit, hasLimit := quantities["limits"+name]
+		if hasRequest && hasLimit && request.Cmp(limit) > 0 {
+			result.errorf("requests"+name, "must not be larger than limits%s", name)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,29 @@
+package v3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestContainerResourceLimitValidate(t *testing.T) {
+	tests := []struct {
+		limit  ContainerResourceLimit
+		errors []string
+	}{
+		{ContainerResourceLimit{RequestsCPU: "100m", LimitsCPU: "1", RequestsMemory: "64Mi", LimitsMemory: "128Mi"}, nil},
+		{ContainerResourceLimit{RequestsCPU: "2"}, nil},
+		{ContainerResourceLimit{RequestsCPU: "a lot", LimitsMemory: "-1Gi"}, []string{"requestsCpu", "limitsMemory"}},
+		{ContainerResourceLimit{RequestsCPU: "2", LimitsCPU: "1", RequestsMemory: "1Gi", LimitsMemory: "512Mi"}, []string{"requestsCpu", "requestsMemory"}},
+	}
+
+	for _, test := range tests {
+		if errors := fields(test.limit.Validate().Errors); !reflect.DeepEqual(errors, test.errors) {
+			t.Errorf("%+v: expected errors of %v, got %v", test.limit, test.errors, errors)
+		}
+	}
+
+	monitoring := &MonitoringConfig{StorageClass: "default", Resources: &ContainerResourceLimit{RequestsCPU: "2", LimitsCPU: "1"}}
+	if errors := fields(monitoring.Validate().Errors); !reflect.DeepEqual(errors, []string{"resources.requestsCpu"}) {
+		t.Errorf("expected an error of resources.requestsCpu, got %v", errors)
+	}
+}
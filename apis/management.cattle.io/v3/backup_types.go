@@ -0,0 +1,52 @@
+package v3
+
+import (
+	"github.com/rancher/norman/condition"
+	"github.com/rancher/norman/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	BackupConditionCreated   condition.Cond = "Created"
+	BackupConditionCompleted condition.Cond = "Completed"
+)
+
+type ClusterBackup struct {
+	types.Namespaced
+
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the desired behavior of the the backup. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status
+	Spec ClusterBackupSpec `json:"spec"`
+	// Most recent observed status of the backup. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status
+	Status ClusterBackupStatus `json:"status"`
+}
+
+type ClusterBackupSpec struct {
+	// cluster the snapshot was taken from
+	ClusterName string `json:"clusterName" norman:"type=reference[cluster],noupdate,required"`
+	// name of the snapshot file on the etcd nodes and in the backup target
+	Filename string `json:"filename,omitempty" norman:"noupdate"`
+	// backup configuration in effect when the snapshot was taken
+	BackupConfig BackupConfig `json:"backupConfig,omitempty" norman:"noupdate"`
+	// true if the snapshot was requested through the backupEtcd action instead of the schedule
+	Manual bool `json:"manual,omitempty" norman:"noupdate"`
+}
+
+type ClusterBackupStatus struct {
+	Conditions []Condition `json:"conditions"`
+	// size of the snapshot in bytes
+	Size int64 `json:"size,omitempty"`
+	// time the snapshot was completed
+	CompletedAt string `json:"completedAt,omitempty"`
+	// object key of the snapshot in the S3 target, empty if it was only kept on the etcd nodes
+	S3Key string `json:"s3Key,omitempty"`
+}
+
+type RestoreFromEtcdBackupInput struct {
+	EtcdBackupName string `json:"etcdBackupName,omitempty" norman:"type=reference[clusterBackup],required"`
+}
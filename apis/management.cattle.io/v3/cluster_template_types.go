@@ -0,0 +1,48 @@
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ClusterTemplate struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the desired behavior of the the template. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status
+	Spec ClusterTemplateSpec `json:"spec"`
+}
+
+type ClusterTemplateSpec struct {
+	DisplayName string `json:"displayName" norman:"required"`
+	Description string `json:"description"`
+	// revision new clusters of the template are created from
+	DefaultRevisionName string `json:"defaultRevisionName,omitempty" norman:"type=reference[clusterTemplateRevision]"`
+}
+
+// ClusterTemplateRevision is an immutable version of a cluster template, only whether it can be used to
+// create clusters can be changed after it is created
+type ClusterTemplateRevision struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the desired behavior of the the revision. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#spec-and-status
+	Spec ClusterTemplateRevisionSpec `json:"spec"`
+}
+
+type ClusterTemplateRevisionSpec struct {
+	DisplayName string `json:"displayName" norman:"required,noupdate"`
+	// false if no new clusters can be created from or upgraded to the revision
+	Enabled *bool `json:"enabled,omitempty" norman:"default=true"`
+	// template the revision belongs to
+	ClusterTemplateName string `json:"clusterTemplateName,omitempty" norman:"type=reference[clusterTemplate],required,noupdate"`
+	// cluster spec of the clusters of the revision, only the RKE config, the default pod security policy
+	// template and the default cluster role for project members are used
+	ClusterConfig *ClusterSpec `json:"clusterConfig" norman:"required,noupdate"`
+	// fields of the cluster config that clusters can override through answers, the variable of a question is
+	// the path of the field in the cluster spec, for example rancherKubernetesEngineConfig.kubernetesVersion
+	Questions []Question `json:"questions,omitempty" norman:"noupdate"`
+}
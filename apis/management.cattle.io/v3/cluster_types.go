@@ -36,6 +36,13 @@ const (
 	ClusterConditionAgentDeployed            condition.Cond = "AgentDeployed"
 	ClusterConditionGlobalAdminsSynced       condition.Cond = "GlobalAdminsSynced"
 	ClusterConditionInitialRolesPopulated    condition.Cond = "InitialRolesPopulated"
+	// ClusterConditionEtcdRestored unknown while the cluster is being restored from an etcd snapshot, false
+	// when the restore failed
+	ClusterConditionEtcdRestored condition.Cond = "EtcdRestored"
+	// ClusterConditionUpgraded unknown while the nodes are being upgraded, false when the upgrade failed
+	ClusterConditionUpgraded condition.Cond = "Upgraded"
+	// ClusterConditionMonitoringEnabled true when cluster monitoring has been deployed
+	ClusterConditionMonitoringEnabled condition.Cond = "MonitoringEnabled"
 
 	ClusterDriverImported = "imported"
 	ClusterDriverLocal    = "local"
@@ -67,6 +74,11 @@ type ClusterSpec struct {
 	AmazonElasticContainerServiceConfig  *AmazonElasticContainerServiceConfig `json:"amazonElasticContainerServiceConfig,omitempty"`
 	DefaultPodSecurityPolicyTemplateName string                               `json:"defaultPodSecurityPolicyTemplateName,omitempty" norman:"type=reference[podSecurityPolicyTemplate]"`
 	DefaultClusterRoleForProjectMembers  string                               `json:"defaultClusterRoleForProjectMembers,omitempty" norman:"type=reference[roleTemplate]"`
+	ClusterTemplateName                  string                               `json:"clusterTemplateName,omitempty" norman:"type=reference[clusterTemplate],noupdate"`
+	ClusterTemplateRevisionName          string                               `json:"clusterTemplateRevisionName,omitempty" norman:"type=reference[clusterTemplateRevision]"`
+	ClusterTemplateAnswers               map[string]string                    `json:"clusterTemplateAnswers,omitempty"`
+	EnableClusterMonitoring              bool                                 `json:"enableClusterMonitoring,omitempty"`
+	MonitoringConfig                     *MonitoringConfig                    `json:"monitoringConfig,omitempty"`
 }
 
 type ImportedConfig struct {
@@ -93,6 +105,24 @@ type ClusterStatus struct {
 	Limits                               v1.ResourceList          `json:"limits,omitempty"`
 	Version                              *version.Info            `json:"version,omitempty"`
 	AppliedPodSecurityPolicyTemplateName string                   `json:"appliedPodSecurityPolicyTemplateId"`
+	UpgradeStatus                        *ClusterUpgradeStatus    `json:"upgradeStatus,omitempty"`
+	PendingPlanChanges                   []NodePlanChange         `json:"pendingPlanChanges,omitempty"`
+}
+
+type ClusterUpgradeStatus struct {
+	// Kubernetes version the nodes are upgraded to
+	TargetVersion string `json:"targetVersion,omitempty"`
+	// Progress of every node in the order they are upgraded
+	Nodes []NodeUpgradeProgress `json:"nodes,omitempty"`
+}
+
+type NodeUpgradeProgress struct {
+	NodeName       string   `json:"nodeName,omitempty" norman:"type=reference[node]"`
+	Address        string   `json:"address,omitempty"`
+	Role           []string `json:"role,omitempty"`
+	State          string   `json:"state,omitempty" norman:"options=pending|draining|upgrading|upgraded|failed|rolledback"`
+	Message        string   `json:"message,omitempty"`
+	LastUpdateTime string   `json:"lastUpdateTime,omitempty"`
 }
 
 type ClusterComponentStatus struct {
@@ -124,8 +154,8 @@ type GoogleKubernetesEngineConfig struct {
 	ClusterIpv4Cidr string `json:"clusterIpv4Cidr,omitempty"`
 	// An optional description of this cluster
 	Description string `json:"description,omitempty"`
-	// The number of nodes in this cluster
-	NodeCount int64 `json:"nodeCount,omitempty" norman:"required"`
+	// The number of nodes in this cluster, describes the default node pool when NodePools is empty
+	NodeCount int64 `json:"nodeCount,omitempty"`
 	// Size of the disk attached to each node
 	DiskSizeGb int64 `json:"diskSizeGb,omitempty"`
 	// The name of a Google Compute Engine
@@ -159,6 +189,69 @@ type GoogleKubernetesEngineConfig struct {
 	SubNetwork string `json:"subNetwork,omitempty"`
 	// Configuration for LegacyAbac
 	EnableLegacyAbac bool `json:"enableLegacyAbac,omitempty"`
+	// Node pools of the cluster, replaces NodeCount, DiskSizeGb, MachineType, ImageType and Labels
+	NodePools []GKENodePool `json:"nodePools,omitempty"`
+	// CIDR blocks that can reach the master over https
+	MasterAuthorizedNetworks *GKEMasterAuthorizedNetworks `json:"masterAuthorizedNetworks,omitempty"`
+	// Configuration of a cluster whose nodes have no public ip addresses
+	PrivateCluster *GKEPrivateCluster `json:"privateCluster,omitempty"`
+	// Start time of the daily maintenance window in HH:MM format, in UTC
+	MaintenanceWindow string `json:"maintenanceWindow,omitempty"`
+	// The map of GCP labels (key/value pairs) to be applied to the cluster resources
+	ResourceLabels map[string]string `json:"resourceLabels,omitempty"`
+}
+
+type GKENodePool struct {
+	// Name of the node pool
+	Name string `json:"name,omitempty" norman:"required"`
+	// The number of nodes the node pool is created with
+	InitialNodeCount int64 `json:"initialNodeCount,omitempty" norman:"default=3"`
+	// The name of a Google Compute Engine machine type
+	MachineType string `json:"machineType,omitempty"`
+	// Size of the disk attached to each node
+	DiskSizeGb int64 `json:"diskSizeGb,omitempty"`
+	// Image Type
+	ImageType string `json:"imageType,omitempty"`
+	// Autoscaling of the number of nodes
+	Autoscaling *GKENodePoolAutoscaling `json:"autoscaling,omitempty"`
+	// Use preemptible VMs for the nodes
+	Preemptible bool `json:"preemptible,omitempty"`
+	// The map of Kubernetes labels (key/value pairs) to be applied to each node
+	Labels map[string]string `json:"labels,omitempty"`
+	// Taints applied to each node
+	Taints []v1.Taint `json:"taints,omitempty"`
+}
+
+type GKENodePoolAutoscaling struct {
+	// Enable autoscaling of the node pool
+	Enabled bool `json:"enabled,omitempty"`
+	// Minimum number of nodes of the node pool
+	MinNodeCount int64 `json:"minNodeCount,omitempty" norman:"default=1"`
+	// Maximum number of nodes of the node pool
+	MaxNodeCount int64 `json:"maxNodeCount,omitempty" norman:"default=3"`
+}
+
+type GKEMasterAuthorizedNetworks struct {
+	// Only allow the CIDR blocks to reach the master
+	Enabled bool `json:"enabled,omitempty"`
+	// CIDR blocks that can reach the master
+	CIDRBlocks []GKECidrBlock `json:"cidrBlocks,omitempty"`
+}
+
+type GKECidrBlock struct {
+	// Display name of the block
+	DisplayName string `json:"displayName,omitempty"`
+	// CIDR block in notation such as 192.168.0.0/24
+	CIDRBlock string `json:"cidrBlock,omitempty" norman:"required"`
+}
+
+type GKEPrivateCluster struct {
+	// Give the nodes internal ip addresses only
+	EnablePrivateNodes bool `json:"enablePrivateNodes,omitempty"`
+	// Only expose the internal ip address of the master
+	EnablePrivateEndpoint bool `json:"enablePrivateEndpoint,omitempty"`
+	// Private /28 ip range of the master
+	MasterIpv4CidrBlock string `json:"masterIpv4CidrBlock,omitempty"`
 }
 
 type AzureKubernetesServiceConfig struct {
@@ -171,15 +264,31 @@ type AzureKubernetesServiceConfig struct {
 	// Resource tags
 	Tag map[string]string `json:"tags,omitempty"`
 	// Number of agents (VMs) to host docker containers. Allowed values must be in the range of 1 to 100 (inclusive). The default value is 1.
+	// Describes the default agent pool when AgentPools is empty.
 	Count int64 `json:"count,omitempty"`
 	// DNS prefix to be used to create the FQDN for the agent pool.
-	AgentDNSPrefix string `json:"agentDnsPrefix,,omitempty"`
-	// FDQN for the agent pool
-	AgentPoolName string `json:"agentPoolName,,omitempty"`
+	AgentDNSPrefix string `json:"agentDnsPrefix,omitempty"`
+	// Name of the default agent pool when AgentPools is empty
+	AgentPoolName string `json:"agentPoolName,omitempty"`
 	// OS Disk Size in GB to be used to specify the disk size for every machine in this master/agent pool. If you specify 0, it will apply the default osDisk size according to the vmSize specified.
+	// Describes the default agent pool when AgentPools is empty.
 	OsDiskSizeGB int64 `json:"osDiskSizeGb,omitempty"`
-	// Size of agent VMs
+	// Size of agent VMs, describes the default agent pool when AgentPools is empty
 	AgentVMSize string `json:"agentVmSize,omitempty"`
+	// Agent pools of the cluster, replaces Count, AgentPoolName, OsDiskSizeGB and AgentVMSize
+	AgentPools []AKSAgentPool `json:"agentPools,omitempty"`
+	// Network plugin, kubenet or azure for Azure CNI
+	NetworkPlugin string `json:"networkPlugin,omitempty" norman:"default=kubenet,options=kubenet|azure"`
+	// Resource ID of the subnet of an existing VNet the agents are placed in, a VNet is created if empty
+	VnetSubnetID string `json:"vnetSubnetId,omitempty"`
+	// CIDR of the kubernetes service ip addresses, must not overlap with any subnet of the VNet
+	ServiceCIDR string `json:"serviceCidr,omitempty"`
+	// IP address of the kubernetes DNS service within ServiceCIDR
+	DNSServiceIP string `json:"dnsServiceIp,omitempty"`
+	// CIDR of the docker bridge on the agents, must not overlap with any subnet of the VNet or ServiceCIDR
+	DockerBridgeCIDR string `json:"dockerBridgeCidr,omitempty"`
+	// Enable kubernetes RBAC
+	EnableRBAC *bool `json:"enableRbac,omitempty" norman:"default=true"`
 	// Version of Kubernetes specified when creating the managed cluster
 	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
 	// Path to the public key to use for SSH into cluster
@@ -198,9 +307,48 @@ type AzureKubernetesServiceConfig struct {
 	ClientSecret string `json:"clientSecret,omitempty" norman:"required,type=password"`
 }
 
+type AKSAgentPool struct {
+	// Name of the agent pool, lowercase letters and digits only
+	Name string `json:"name,omitempty" norman:"required"`
+	// Number of agents (VMs) of the pool, between 1 and 100
+	Count int64 `json:"count,omitempty" norman:"default=1"`
+	// Size of the agent VMs
+	VMSize string `json:"vmSize,omitempty"`
+	// OS Disk Size in GB of every agent, 0 applies the default size of VMSize
+	OsDiskSizeGB int64 `json:"osDiskSizeGb,omitempty"`
+	// Maximum number of pods per agent
+	MaxPods int64 `json:"maxPods,omitempty"`
+}
+
 type AmazonElasticContainerServiceConfig struct {
+	// Access key of the AWS account the cluster is created in
 	AccessKey string `json:"accessKey" norman:"required"`
+	// Secret key of the access key
 	SecretKey string `json:"secretKey" norman:"required,type=password"`
+	// The region to launch the cluster
+	Region string `json:"region,omitempty" norman:"required,default=us-west-2"`
+	// Version of Kubernetes of the control plane and the worker nodes
+	KubernetesVersion string `json:"kubernetesVersion,omitempty" norman:"default=1.10"`
+	// EC2 instance type of the worker nodes
+	InstanceType string `json:"instanceType,omitempty" norman:"default=m4.large"`
+	// Minimum number of worker nodes of the autoscaling group
+	MinimumNodes int64 `json:"minimumNodes,omitempty" norman:"default=1"`
+	// Maximum number of worker nodes of the autoscaling group
+	MaximumNodes int64 `json:"maximumNodes,omitempty" norman:"default=3"`
+	// Number of worker nodes the autoscaling group starts with
+	DesiredNodes int64 `json:"desiredNodes,omitempty" norman:"default=3"`
+	// ID of the VPC of the cluster, a VPC is created if empty
+	VirtualNetwork string `json:"virtualNetwork,omitempty"`
+	// IDs of the subnets of the VPC the control plane and the worker nodes are placed in
+	Subnets []string `json:"subnets,omitempty"`
+	// IDs of the security groups of the control plane
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+	// Name or ARN of the IAM role EKS uses to manage resources, a role is created if empty
+	ServiceRole string `json:"serviceRole,omitempty"`
+	// ID of the AMI of the worker nodes, defaults to the EKS optimized AMI of the region
+	AMI string `json:"ami,omitempty"`
+	// Name of the EC2 key pair that can be used to ssh into the worker nodes
+	KeyPairName string `json:"keyPairName,omitempty"`
 }
 
 type ClusterEvent struct {
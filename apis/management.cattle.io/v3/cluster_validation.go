@@ -0,0 +1,357 @@
+package v3
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	// EKSKubernetesVersions are the values accepted in AmazonElasticContainerServiceConfig.KubernetesVersion
+	EKSKubernetesVersions = []string{"1.10"}
+
+	awsRegionRegexp       = regexp.MustCompile(`^[a-z]{2}(-gov)?-[a-z]+-[0-9]$`)
+	awsInstanceTypeRegexp = regexp.MustCompile(`^[a-z][a-z0-9-]*\.[a-z0-9]+$`)
+	awsRoleARNRegexp      = regexp.MustCompile(`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`)
+	awsRoleNameRegexp     = regexp.MustCompile(`^[\w+=,.@-]{1,64}$`)
+
+	// the node counts of EKS clusters when the fields are not set, they match the defaults of the fields
+	eksDefaultMinimumNodes int64 = 1
+	eksDefaultMaximumNodes int64 = 3
+	eksDefaultDesiredNodes int64 = 3
+
+	gkeNodePoolNameRegexp  = regexp.MustCompile(`^[a-z]([-a-z0-9]{0,38}[a-z0-9])?$`)
+	gkeLabelKeyRegexp      = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+	gkeLabelValueRegexp    = regexp.MustCompile(`^[a-z0-9_-]{0,63}$`)
+	gkeMaxAuthorizedBlocks = 50
+
+	aksAgentPoolNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9]{0,11}$`)
+	aksSubnetIDRegexp      = regexp.MustCompile(`(?i)^/subscriptions/[^/]+/resourceGroups/[^/]+/providers/Microsoft\.Network/virtualNetworks/[^/]+/subnets/[^/]+$`)
+)
+
+// Validate checks the EKS config for mistakes that would otherwise only show up while creating the cluster
+func (e *AmazonElasticContainerServiceConfig) Validate() ValidationResult {
+	result := ValidationResult{}
+
+	if e.AccessKey == "" {
+		result.errorf("accessKey", "access key is required")
+	}
+	if e.SecretKey == "" {
+		result.errorf("secretKey", "secret key is required")
+	}
+	if e.Region != "" && !awsRegionRegexp.MatchString(e.Region) {
+		result.errorf("region", "[%s] is not a valid region", e.Region)
+	}
+	if e.KubernetesVersion != "" && !contains(EKSKubernetesVersions, e.KubernetesVersion) {
+		result.errorf("kubernetesVersion", "unsupported version [%s], must be one of %v", e.KubernetesVersion, EKSKubernetesVersions)
+	}
+	if e.InstanceType != "" && !awsInstanceTypeRegexp.MatchString(e.InstanceType) {
+		result.errorf("instanceType", "[%s] is not a valid instance type", e.InstanceType)
+	}
+
+	// configs created before the node counts were added leave them unset, zero is the default
+	minimum := defaultInt64(e.MinimumNodes, eksDefaultMinimumNodes)
+	maximum := defaultInt64(e.MaximumNodes, eksDefaultMaximumNodes)
+	desired := defaultInt64(e.DesiredNodes, eksDefaultDesiredNodes)
+	if minimum < 0 {
+		result.errorf("minimumNodes", "must not be negative")
+	}
+	if maximum < 1 {
+		result.errorf("maximumNodes", "must be at least 1")
+	} else if maximum < minimum {
+		result.errorf("maximumNodes", "must not be less than minimumNodes [%d]", minimum)
+	}
+	if desired < minimum || desired > maximum {
+		result.errorf("desiredNodes", "must be between minimumNodes [%d] and maximumNodes [%d]", minimum, maximum)
+	}
+
+	if e.VirtualNetwork != "" && !strings.HasPrefix(e.VirtualNetwork, "vpc-") {
+		result.errorf("virtualNetwork", "[%s] is not a VPC id", e.VirtualNetwork)
+	}
+	if e.VirtualNetwork == "" && (len(e.Subnets) > 0 || len(e.SecurityGroups) > 0) {
+		result.errorf("virtualNetwork", "required when subnets or securityGroups are set")
+	}
+	if e.VirtualNetwork != "" && len(e.Subnets) < 2 {
+		result.errorf("subnets", "at least two subnets in different availability zones are required when virtualNetwork is set")
+	}
+	if e.VirtualNetwork != "" && len(e.SecurityGroups) == 0 {
+		result.errorf("securityGroups", "at least one security group is required when virtualNetwork is set")
+	}
+	validateAWSIDs("subnets", "subnet-", e.Subnets, &result)
+	validateAWSIDs("securityGroups", "sg-", e.SecurityGroups, &result)
+
+	if e.ServiceRole != "" && !awsRoleARNRegexp.MatchString(e.ServiceRole) && !awsRoleNameRegexp.MatchString(e.ServiceRole) {
+		result.errorf("serviceRole", "[%s] is not a valid role name or ARN", e.ServiceRole)
+	}
+	if e.AMI != "" && !strings.HasPrefix(e.AMI, "ami-") {
+		result.errorf("ami", "[%s] is not an AMI id", e.AMI)
+	}
+
+	return result
+}
+
+func validateAWSIDs(field, prefix string, ids []string, result *ValidationResult) {
+	seen := map[string]bool{}
+	for i, id := range ids {
+		path := fmt.Sprintf("%s[%d]", field, i)
+		if !strings.HasPrefix(id, prefix) {
+			result.errorf(path, "[%s] is not a valid id, must start with %s", id, prefix)
+		} else if seen[id] {
+			result.errorf(path, "[%s] is listed more than once", id)
+		}
+		seen[id] = true
+	}
+}
+
+// Validate checks the GKE config for mistakes that would otherwise only show up while creating the cluster
+func (g *GoogleKubernetesEngineConfig) Validate() ValidationResult {
+	result := ValidationResult{}
+
+	if g.ProjectID == "" {
+		result.errorf("projectId", "project id is required")
+	}
+	if g.Zone == "" {
+		result.errorf("zone", "zone is required")
+	}
+	if g.Credential == "" {
+		result.errorf("credential", "credential is required")
+	}
+
+	if len(g.NodePools) > 0 && g.hasFlatNodePool() {
+		result.errorf("nodePools", "nodeCount, diskSizeGb, machineType, imageType and labels can not be combined with nodePools, set them on the node pools")
+	}
+	pools := g.EffectiveNodePools()
+	if len(pools) == 0 {
+		result.errorf("nodePools", "at least one node pool is required")
+	}
+	names := map[string]int{}
+	for i, pool := range pools {
+		path := fmt.Sprintf("nodePools[%d]", i)
+		if len(g.NodePools) == 0 {
+			// the pool is described by the flat fields
+			path = ""
+		}
+		validateGKENodePool(path, pool, &result)
+		if j, ok := names[pool.Name]; ok {
+			result.errorf(path+".name", "node pool name [%s] is already used by nodePools[%d]", pool.Name, j)
+		}
+		names[pool.Name] = i
+	}
+
+	if networks := g.MasterAuthorizedNetworks; networks != nil && networks.Enabled {
+		if len(networks.CIDRBlocks) > gkeMaxAuthorizedBlocks {
+			result.errorf("masterAuthorizedNetworks.cidrBlocks", "at most %d cidr blocks are supported", gkeMaxAuthorizedBlocks)
+		}
+		for i, block := range networks.CIDRBlocks {
+			parseCIDR(&result, fmt.Sprintf("masterAuthorizedNetworks.cidrBlocks[%d].cidrBlock", i), block.CIDRBlock)
+		}
+	}
+
+	if private := g.PrivateCluster; private != nil {
+		if private.EnablePrivateEndpoint && !private.EnablePrivateNodes {
+			result.errorf("privateCluster.enablePrivateEndpoint", "requires enablePrivateNodes")
+		}
+		if private.EnablePrivateEndpoint && (g.MasterAuthorizedNetworks == nil || !g.MasterAuthorizedNetworks.Enabled) {
+			result.errorf("privateCluster.enablePrivateEndpoint", "requires masterAuthorizedNetworks to be enabled")
+		}
+		if private.EnablePrivateNodes {
+			if private.MasterIpv4CidrBlock == "" {
+				result.errorf("privateCluster.masterIpv4CidrBlock", "required when enablePrivateNodes is set")
+			} else if block := parseCIDR(&result, "privateCluster.masterIpv4CidrBlock", private.MasterIpv4CidrBlock); block != nil {
+				if ones, _ := block.Mask.Size(); ones != 28 || block.IP.To4() == nil {
+					result.errorf("privateCluster.masterIpv4CidrBlock", "[%s] must be an ipv4 /28 range", private.MasterIpv4CidrBlock)
+				} else if !isPrivateIPv4(block.IP) {
+					result.errorf("privateCluster.masterIpv4CidrBlock", "[%s] must be a private range", private.MasterIpv4CidrBlock)
+				}
+			}
+		} else if private.MasterIpv4CidrBlock != "" {
+			result.warnf("privateCluster.masterIpv4CidrBlock", "ignored unless enablePrivateNodes is set")
+		}
+	}
+
+	if g.MaintenanceWindow != "" {
+		if _, err := time.Parse("15:04", g.MaintenanceWindow); err != nil || len(g.MaintenanceWindow) != 5 {
+			result.errorf("maintenanceWindow", "[%s] is not a time in HH:MM format", g.MaintenanceWindow)
+		}
+	}
+	validateGKELabels("resourceLabels", g.ResourceLabels, &result)
+
+	return result
+}
+
+func validateGKENodePool(path string, pool GKENodePool, result *ValidationResult) {
+	field := func(name string) string {
+		if path == "" {
+			return name
+		}
+		return path + "." + name
+	}
+
+	if !gkeNodePoolNameRegexp.MatchString(pool.Name) {
+		result.errorf(field("name"), "[%s] is not a valid node pool name", pool.Name)
+	}
+	countField := field("initialNodeCount")
+	if path == "" {
+		countField = "nodeCount"
+	}
+	if pool.InitialNodeCount < 1 {
+		result.errorf(countField, "must be at least 1")
+	}
+	if pool.DiskSizeGb != 0 && pool.DiskSizeGb < 10 {
+		result.errorf(field("diskSizeGb"), "must be at least 10")
+	}
+
+	if autoscaling := pool.Autoscaling; autoscaling != nil && autoscaling.Enabled {
+		if autoscaling.MinNodeCount < 0 {
+			result.errorf(field("autoscaling.minNodeCount"), "must not be negative")
+		}
+		if autoscaling.MaxNodeCount < 1 {
+			result.errorf(field("autoscaling.maxNodeCount"), "must be at least 1")
+		} else if autoscaling.MaxNodeCount < autoscaling.MinNodeCount {
+			result.errorf(field("autoscaling.maxNodeCount"), "must not be less than minNodeCount [%d]", autoscaling.MinNodeCount)
+		}
+		if pool.InitialNodeCount < autoscaling.MinNodeCount || pool.InitialNodeCount > autoscaling.MaxNodeCount {
+			result.warnf(countField, "outside of the autoscaling range, the autoscaler will resize the node pool")
+		}
+	}
+
+	validateGKELabels(field("labels"), pool.Labels, result)
+	validateTaints(field("taints"), pool.Taints, result)
+}
+
+func validateGKELabels(field string, labels map[string]string, result *ValidationResult) {
+	for _, key := range sortedKeys(labels) {
+		if !gkeLabelKeyRegexp.MatchString(key) {
+			result.errorf(field, "[%s] is not a valid label key, must start with a lowercase letter and contain only lowercase letters, digits, - and _", key)
+		}
+		if !gkeLabelValueRegexp.MatchString(labels[key]) {
+			result.errorf(field, "[%s] is not a valid value of label [%s], must contain only lowercase letters, digits, - and _", labels[key], key)
+		}
+	}
+}
+
+func isPrivateIPv4(ip net.IP) bool {
+	for _, block := range []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"} {
+		_, private, _ := net.ParseCIDR(block)
+		if private.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the AKS config for mistakes that would otherwise only show up while creating the cluster
+func (a *AzureKubernetesServiceConfig) Validate() ValidationResult {
+	result := ValidationResult{}
+
+	for _, required := range []struct{ field, value string }{
+		{"subscriptionId", a.SubscriptionID},
+		{"resourceGroup", a.ResourceGroup},
+		{"sshPublicKeyContents", a.SSHPublicKeyContents},
+		{"clientId", a.ClientID},
+		{"tenantId", a.TenantID},
+		{"clientSecret", a.ClientSecret},
+	} {
+		if required.value == "" {
+			result.errorf(required.field, "%s is required", required.field)
+		}
+	}
+
+	if len(a.AgentPools) > 0 && a.hasFlatAgentPool() {
+		result.errorf("agentPools", "count, agentPoolName, osDiskSizeGb and agentVmSize can not be combined with agentPools, set them on the agent pools")
+	}
+	names := map[string]int{}
+	for i, pool := range a.EffectiveAgentPools() {
+		path := fmt.Sprintf("agentPools[%d]", i)
+		nameField, countField, diskField := path+".name", path+".count", path+".osDiskSizeGb"
+		if len(a.AgentPools) == 0 {
+			// the pool is described by the flat fields
+			nameField, countField, diskField = "agentPoolName", "count", "osDiskSizeGb"
+		}
+
+		if !aksAgentPoolNameRegexp.MatchString(pool.Name) {
+			result.errorf(nameField, "[%s] is not a valid agent pool name, must start with a lowercase letter and have at most 12 lowercase letters and digits", pool.Name)
+		} else if j, ok := names[pool.Name]; ok {
+			result.errorf(nameField, "agent pool name [%s] is already used by agentPools[%d]", pool.Name, j)
+		}
+		names[pool.Name] = i
+
+		if pool.Count < 1 || pool.Count > 100 {
+			result.errorf(countField, "must be between 1 and 100")
+		}
+		if pool.OsDiskSizeGB < 0 || pool.OsDiskSizeGB > 1023 {
+			result.errorf(diskField, "must be between 0 and 1023")
+		}
+		if pool.MaxPods != 0 && (pool.MaxPods < 10 || pool.MaxPods > 250) {
+			result.errorf(path+".maxPods", "must be between 10 and 250")
+		}
+	}
+
+	switch a.NetworkPlugin {
+	case "", "kubenet":
+	case "azure":
+		if a.VnetSubnetID == "" {
+			result.errorf("vnetSubnetId", "required when the network plugin is azure")
+		}
+	default:
+		result.errorf("networkPlugin", "unsupported network plugin [%s], must be kubenet or azure", a.NetworkPlugin)
+	}
+	if a.VnetSubnetID != "" && !aksSubnetIDRegexp.MatchString(a.VnetSubnetID) {
+		result.errorf("vnetSubnetId", "[%s] is not the resource id of a subnet", a.VnetSubnetID)
+	}
+	a.validateNetworkRanges(&result)
+
+	return result
+}
+
+// validateNetworkRanges checks the service CIDR, the DNS service ip and the docker bridge CIDR, which AKS
+// only accepts together
+func (a *AzureKubernetesServiceConfig) validateNetworkRanges(result *ValidationResult) {
+	if a.ServiceCIDR == "" && a.DNSServiceIP == "" && a.DockerBridgeCIDR == "" {
+		return
+	}
+	if a.ServiceCIDR == "" || a.DNSServiceIP == "" || a.DockerBridgeCIDR == "" {
+		result.errorf("serviceCidr", "serviceCidr, dnsServiceIp and dockerBridgeCidr must be set together")
+		return
+	}
+
+	serviceCIDR := parseCIDR(result, "serviceCidr", a.ServiceCIDR)
+	dockerBridgeCIDR := parseCIDR(result, "dockerBridgeCidr", a.DockerBridgeCIDR)
+	dnsServiceIP := net.ParseIP(a.DNSServiceIP)
+	if dnsServiceIP == nil {
+		result.errorf("dnsServiceIp", "[%s] is not a valid ip address", a.DNSServiceIP)
+	}
+
+	if serviceCIDR != nil && dnsServiceIP != nil {
+		if !serviceCIDR.Contains(dnsServiceIP) {
+			result.errorf("dnsServiceIp", "[%s] is not within the service cidr [%s]", a.DNSServiceIP, serviceCIDR)
+		} else if first := firstIP(serviceCIDR); dnsServiceIP.Equal(first) {
+			result.errorf("dnsServiceIp", "[%s] is used by the kubernetes api service, use another address of the service cidr", a.DNSServiceIP)
+		}
+	}
+	if serviceCIDR != nil && dockerBridgeCIDR != nil && overlaps(serviceCIDR, dockerBridgeCIDR) {
+		result.errorf("dockerBridgeCidr", "[%s] overlaps with the service cidr [%s]", dockerBridgeCIDR, serviceCIDR)
+	}
+}
+
+// firstIP returns the first usable address of a network, the one kubernetes gives its api service
+func firstIP(network *net.IPNet) net.IP {
+	ip := make(net.IP, len(network.IP))
+	copy(ip, network.IP)
+	for i := len(ip) - 1; i >= 0; i-- {
+		ip[i]++
+		if ip[i] != 0 {
+			break
+		}
+	}
+	return ip
+}
+
+func defaultInt64(value, def int64) int64 {
+	if value == 0 {
+		return def
+	}
+	return value
+}
@@ -0,0 +1,59 @@
+package v3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAmazonElasticContainerServiceConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config AmazonElasticContainerServiceConfig
+		errors []string
+	}{
+		{
+			name:   "legacy config with only the keys",
+			config: AmazonElasticContainerServiceConfig{AccessKey: "key", SecretKey: "secret"},
+		},
+		{
+			name:   "legacy config with a region",
+			config: AmazonElasticContainerServiceConfig{AccessKey: "key", SecretKey: "secret", Region: "us-west-2", InstanceType: "m4.large"},
+		},
+		{
+			name:   "missing keys",
+			config: AmazonElasticContainerServiceConfig{},
+			errors: []string{"accessKey", "secretKey"},
+		},
+		{
+			name:   "node counts",
+			config: AmazonElasticContainerServiceConfig{AccessKey: "key", SecretKey: "secret", MinimumNodes: 2, MaximumNodes: 10, DesiredNodes: 4},
+		},
+		{
+			name:   "desired nodes above the default maximum",
+			config: AmazonElasticContainerServiceConfig{AccessKey: "key", SecretKey: "secret", DesiredNodes: 5},
+			errors: []string{"desiredNodes"},
+		},
+		{
+			name:   "maximum nodes below the default desired nodes",
+			config: AmazonElasticContainerServiceConfig{AccessKey: "key", SecretKey: "secret", MaximumNodes: 2},
+			errors: []string{"desiredNodes"},
+		},
+		{
+			name:   "maximum below minimum",
+			config: AmazonElasticContainerServiceConfig{AccessKey: "key", SecretKey: "secret", MinimumNodes: 4, MaximumNodes: 2, DesiredNodes: 3},
+			errors: []string{"maximumNodes", "desiredNodes"},
+		},
+		{
+			name:   "negative counts",
+			config: AmazonElasticContainerServiceConfig{AccessKey: "key", SecretKey: "secret", MinimumNodes: -1, MaximumNodes: -1},
+			errors: []string{"minimumNodes", "maximumNodes", "desiredNodes"},
+		},
+	}
+
+	for _, test := range tests {
+		result := test.config.Validate()
+		if errors := fields(result.Errors); !reflect.DeepEqual(errors, test.errors) {
+			t.Errorf("%s: expected errors %v, got %v", test.name, test.errors, result.Errors)
+		}
+	}
+}
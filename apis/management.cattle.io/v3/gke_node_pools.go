@@ -0,0 +1,51 @@
+package v3
+
+// DefaultGKENodePoolName is the name of the node pool described by the flat fields of
+// GoogleKubernetesEngineConfig, it is the name GKE gives the pool of a cluster created without node pools
+const DefaultGKENodePoolName = "default-pool"
+
+// hasFlatNodePool reports whether any of the fields that describe the default node pool are set
+func (g *GoogleKubernetesEngineConfig) hasFlatNodePool() bool {
+	return g.NodeCount != 0 || g.DiskSizeGb != 0 || g.MachineType != "" || g.ImageType != "" || len(g.Labels) > 0
+}
+
+// EffectiveNodePools returns the node pools of the cluster. A config written before node pools were
+// supported describes a single pool through NodeCount, DiskSizeGb, MachineType, ImageType and Labels.
+func (g *GoogleKubernetesEngineConfig) EffectiveNodePools() []GKENodePool {
+	if len(g.NodePools) > 0 || !g.hasFlatNodePool() {
+		return g.NodePools
+	}
+
+	var labels map[string]string
+	if len(g.Labels) > 0 {
+		labels = make(map[string]string, len(g.Labels))
+		for k, v := range g.Labels {
+			labels[k] = v
+		}
+	}
+
+	return []GKENodePool{{
+		Name:             DefaultGKENodePoolName,
+		InitialNodeCount: g.NodeCount,
+		MachineType:      g.MachineType,
+		DiskSizeGb:       g.DiskSizeGb,
+		ImageType:        g.ImageType,
+		Labels:           labels,
+	}}
+}
+
+// MigrateNodePools moves the default node pool described by the flat fields into NodePools and clears the
+// flat fields. It returns false if there was nothing to migrate.
+func (g *GoogleKubernetesEngineConfig) MigrateNodePools() bool {
+	if len(g.NodePools) > 0 || !g.hasFlatNodePool() {
+		return false
+	}
+
+	g.NodePools = g.EffectiveNodePools()
+	g.NodeCount = 0
+	g.DiskSizeGb = 0
+	g.MachineType = ""
+	g.ImageType = ""
+	g.Labels = nil
+	return true
+}
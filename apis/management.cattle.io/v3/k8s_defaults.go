@@ -23,6 +23,8 @@ var (
 			DNSmasq:                   m("gcr.io/google_containers/k8s-dns-dnsmasq-nanny-amd64:1.14.5"),
 			KubeDNSSidecar:            m("gcr.io/google_containers/k8s-dns-sidecar-amd64:1.14.5"),
 			KubeDNSAutoscaler:         m("gcr.io/google_containers/cluster-proportional-autoscaler-amd64:1.0.0"),
+			CoreDNS:                   m("coredns/coredns:1.0.6"),
+			CoreDNSAutoscaler:         m("gcr.io/google_containers/cluster-proportional-autoscaler-amd64:1.0.0"),
 			Flannel:                   m("quay.io/coreos/flannel:v0.9.1"),
 			FlannelCNI:                m("quay.io/coreos/flannel-cni:v0.2.0"),
 			CalicoNode:                m("quay.io/calico/node:v3.1.1"),
@@ -48,6 +50,8 @@ var (
 			DNSmasq:                   m("gcr.io/google_containers/k8s-dns-dnsmasq-nanny-amd64:1.14.5"),
 			KubeDNSSidecar:            m("gcr.io/google_containers/k8s-dns-sidecar-amd64:1.14.5"),
 			KubeDNSAutoscaler:         m("gcr.io/google_containers/cluster-proportional-autoscaler-amd64:1.0.0"),
+			CoreDNS:                   m("coredns/coredns:1.0.6"),
+			CoreDNSAutoscaler:         m("gcr.io/google_containers/cluster-proportional-autoscaler-amd64:1.0.0"),
 			Flannel:                   m("quay.io/coreos/flannel:v0.9.1"),
 			FlannelCNI:                m("quay.io/coreos/flannel-cni:v0.2.0"),
 			CalicoNode:                m("quay.io/calico/node:v3.1.1"),
@@ -73,6 +77,8 @@ var (
 			DNSmasq:                   m("gcr.io/google_containers/k8s-dns-dnsmasq-nanny-amd64:1.14.7"),
 			KubeDNSSidecar:            m("gcr.io/google_containers/k8s-dns-sidecar-amd64:1.14.7"),
 			KubeDNSAutoscaler:         m("gcr.io/google_containers/cluster-proportional-autoscaler-amd64:1.0.0"),
+			CoreDNS:                   m("coredns/coredns:1.0.6"),
+			CoreDNSAutoscaler:         m("gcr.io/google_containers/cluster-proportional-autoscaler-amd64:1.0.0"),
 			Flannel:                   m("quay.io/coreos/flannel:v0.9.1"),
 			FlannelCNI:                m("quay.io/coreos/flannel-cni:v0.2.0"),
 			CalicoNode:                m("quay.io/calico/node:v3.1.1"),
@@ -98,6 +104,8 @@ var (
 			DNSmasq:                   m("gcr.io/google_containers/k8s-dns-dnsmasq-nanny-amd64:1.14.7"),
 			KubeDNSSidecar:            m("gcr.io/google_containers/k8s-dns-sidecar-amd64:1.14.7"),
 			KubeDNSAutoscaler:         m("gcr.io/google_containers/cluster-proportional-autoscaler-amd64:1.0.0"),
+			CoreDNS:                   m("coredns/coredns:1.0.6"),
+			CoreDNSAutoscaler:         m("gcr.io/google_containers/cluster-proportional-autoscaler-amd64:1.0.0"),
 			Flannel:                   m("quay.io/coreos/flannel:v0.9.1"),
 			FlannelCNI:                m("quay.io/coreos/flannel-cni:v0.2.0"),
 			CalicoNode:                m("quay.io/calico/node:v3.1.1"),
@@ -123,6 +131,8 @@ var (
 			DNSmasq:                   m("gcr.io/google_containers/k8s-dns-dnsmasq-nanny-amd64:1.14.8"),
 			KubeDNSSidecar:            m("gcr.io/google_containers/k8s-dns-sidecar-amd64:1.14.8"),
 			KubeDNSAutoscaler:         m("gcr.io/google_containers/cluster-proportional-autoscaler-amd64:1.0.0"),
+			CoreDNS:                   m("coredns/coredns:1.1.3"),
+			CoreDNSAutoscaler:         m("gcr.io/google_containers/cluster-proportional-autoscaler-amd64:1.0.0"),
 			Flannel:                   m("quay.io/coreos/flannel:v0.9.1"),
 			FlannelCNI:                m("quay.io/coreos/flannel-cni:v0.2.0"),
 			CalicoNode:                m("quay.io/calico/node:v3.1.1"),
@@ -148,6 +158,8 @@ var (
 			DNSmasq:                   m("gcr.io/google_containers/k8s-dns-dnsmasq-nanny-amd64:1.14.8"),
 			KubeDNSSidecar:            m("gcr.io/google_containers/k8s-dns-sidecar-amd64:1.14.8"),
 			KubeDNSAutoscaler:         m("gcr.io/google_containers/cluster-proportional-autoscaler-amd64:1.0.0"),
+			CoreDNS:                   m("coredns/coredns:1.1.3"),
+			CoreDNSAutoscaler:         m("gcr.io/google_containers/cluster-proportional-autoscaler-amd64:1.0.0"),
 			Flannel:                   m("quay.io/coreos/flannel:v0.9.1"),
 			FlannelCNI:                m("quay.io/coreos/flannel-cni:v0.2.0"),
 			CalicoNode:                m("quay.io/calico/node:v3.1.1"),
@@ -178,16 +190,25 @@ var (
 		},
 	}
 
-	// ToolsSystemImages default images for alert, pipeline, logging
+	// ToolsSystemImages default images for alert, monitoring, pipeline, logging
 	ToolsSystemImages = struct {
-		AlertSystemImages    AlertSystemImages
-		PipelineSystemImages PipelineSystemImages
-		LoggingSystemImages  LoggingSystemImages
+		AlertSystemImages      AlertSystemImages
+		MonitoringSystemImages MonitoringSystemImages
+		PipelineSystemImages   PipelineSystemImages
+		LoggingSystemImages    LoggingSystemImages
 	}{
 		AlertSystemImages: AlertSystemImages{
 			AlertManager:       m("prom/alertmanager:v0.11.0"),
 			AlertManagerHelper: m("rancher/alertmanager-helper:v0.0.2"),
 		},
+		MonitoringSystemImages: MonitoringSystemImages{
+			Prometheus:               m("prom/prometheus:v2.3.2"),
+			PrometheusConfigReloader: m("quay.io/coreos/prometheus-config-reloader:v0.23.2"),
+			PrometheusOperator:       m("quay.io/coreos/prometheus-operator:v0.23.2"),
+			NodeExporter:             m("prom/node-exporter:v0.16.0"),
+			KubeStateMetrics:         m("quay.io/coreos/kube-state-metrics:v1.3.1"),
+			Grafana:                  m("grafana/grafana:5.2.2"),
+		},
 		PipelineSystemImages: PipelineSystemImages{
 			Jenkins:       m("jenkins/jenkins:2.107-slim"),
 			JenkinsJnlp:   m("jenkins/jnlp-slave:3.10-1-alpine"),
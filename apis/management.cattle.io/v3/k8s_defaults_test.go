@@ -0,0 +1,14 @@
+package v3
+
+import "testing"
+
+func TestSystemImagesHaveCoreDNS(t *testing.T) {
+	if _, ok := K8sVersionToRKESystemImages[DefaultK8s]; !ok {
+		t.Errorf("expected system images of the default version %s", DefaultK8s)
+	}
+	for version, images := range K8sVersionToRKESystemImages {
+		if images.CoreDNS == "" || images.CoreDNSAutoscaler == "" {
+			t.Errorf("%s: expected the coredns and coredns autoscaler images, got %q and %q", version, images.CoreDNS, images.CoreDNSAutoscaler)
+		}
+	}
+}
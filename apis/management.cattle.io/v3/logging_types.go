@@ -47,6 +47,34 @@ type LoggingCommonSpec struct {
 	SplunkConfig        *SplunkConfig        `json:"splunkConfig,omitempty"`
 	KafkaConfig         *KafkaConfig         `json:"kafkaConfig,omitempty"`
 	SyslogConfig        *SyslogConfig        `json:"syslogConfig,omitempty"`
+
+	// Filter selects the log lines that are sent, all lines are sent without it
+	Filter *LoggingFilter `json:"filter,omitempty"`
+}
+
+// LoggingFilter selects log lines by the container that wrote them and by their content. A line is sent if
+// it matches every criterion of Include, no criterion of Exclude, and one of IncludeLines if any are set.
+type LoggingFilter struct {
+	Include *LoggingSelector `json:"include,omitempty"`
+	Exclude *LoggingSelector `json:"exclude,omitempty"`
+	// IncludeLines are regular expressions, a line must match one of them
+	IncludeLines []string `json:"includeLines,omitempty"`
+	// ExcludeLines are regular expressions, a line that matches one of them is dropped
+	ExcludeLines []string `json:"excludeLines,omitempty"`
+	// LevelExtraction parses the level of lines into the level field
+	LevelExtraction *LogLevelExtraction `json:"levelExtraction,omitempty"`
+}
+
+type LoggingSelector struct {
+	Namespaces []string `json:"namespaces,omitempty"`
+	// WorkloadSelector matches the labels of the pods of workloads
+	WorkloadSelector map[string]string `json:"workloadSelector,omitempty"`
+	Containers       []string          `json:"containers,omitempty"`
+}
+
+type LogLevelExtraction struct {
+	// Pattern is a regular expression with a group named level, the default matches the common level names
+	Pattern string `json:"pattern,omitempty"`
 }
 
 type ClusterLoggingSpec struct {
@@ -0,0 +1,90 @@
+package v3
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// LogLevelGroup is the name of the group of level extraction patterns that holds the level
+const LogLevelGroup = "level"
+
+// Validate checks the selectors and the regular expressions of the filter. Patterns are checked with the go
+// syntax, which fluentd accepts except for the go only (?P<name>) groups, which are rewritten when the
+// filter is rendered.
+func (f *LoggingFilter) Validate() ValidationResult {
+	result := ValidationResult{}
+
+	if f.Include != nil {
+		validateLoggingSelector("include", f.Include, &result)
+	}
+	if f.Exclude != nil {
+		validateLoggingSelector("exclude", f.Exclude, &result)
+	}
+	if f.Include != nil && f.Exclude != nil {
+		excluded := map[string]bool{}
+		for _, namespace := range f.Exclude.Namespaces {
+			excluded[namespace] = true
+		}
+		for _, namespace := range f.Include.Namespaces {
+			if excluded[namespace] {
+				result.warnf("exclude.namespaces", "namespace [%s] is included and excluded, its logs are not sent", namespace)
+			}
+		}
+	}
+
+	for _, pattern := range f.IncludeLines {
+		validateLogPattern("includeLines", pattern, &result)
+	}
+	for _, pattern := range f.ExcludeLines {
+		validateLogPattern("excludeLines", pattern, &result)
+	}
+
+	if f.LevelExtraction != nil && f.LevelExtraction.Pattern != "" {
+		if re := validateLogPattern("levelExtraction.pattern", f.LevelExtraction.Pattern, &result); re != nil {
+			found := false
+			for _, name := range re.SubexpNames() {
+				found = found || name == LogLevelGroup
+			}
+			if !found {
+				result.errorf("levelExtraction.pattern", "[%s] has no group named %s", f.LevelExtraction.Pattern, LogLevelGroup)
+			}
+		}
+	}
+
+	return result
+}
+
+func validateLoggingSelector(field string, selector *LoggingSelector, result *ValidationResult) {
+	for _, namespace := range selector.Namespaces {
+		for _, msg := range validation.IsDNS1123Label(namespace) {
+			result.errorf(field+".namespaces", "invalid namespace [%s]: %s", namespace, msg)
+		}
+	}
+	for _, key := range sortedKeys(selector.WorkloadSelector) {
+		for _, msg := range validation.IsQualifiedName(key) {
+			result.errorf(field+".workloadSelector", "invalid key [%s]: %s", key, msg)
+		}
+		for _, msg := range validation.IsValidLabelValue(selector.WorkloadSelector[key]) {
+			result.errorf(field+".workloadSelector", "invalid value of [%s]: %s", key, msg)
+		}
+	}
+	for _, container := range selector.Containers {
+		for _, msg := range validation.IsDNS1123Label(container) {
+			result.errorf(field+".containers", "invalid container name [%s]: %s", container, msg)
+		}
+	}
+}
+
+func validateLogPattern(field, pattern string, result *ValidationResult) *regexp.Regexp {
+	if pattern == "" {
+		result.errorf(field, "pattern can not be empty")
+		return nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		result.errorf(field, "[%s] is not a valid regular expression: %v", pattern, err)
+		return nil
+	}
+	return re
+}
@@ -72,6 +72,7 @@ type NodeStatus struct {
 	NodeAnnotations    map[string]string `json:"nodeAnnotations,omitempty"`
 	NodeLabels         map[string]string `json:"nodeLabels,omitempty"`
 	NodeTaints         []v1.Taint        `json:"nodeTaints,omitempty"`
+	AppliedNodeTaints  []v1.Taint        `json:"appliedNodeTaints,omitempty"`
 	DockerInfo         *DockerInfo       `json:"dockerInfo,omitempty"`
 }
 
@@ -147,6 +148,7 @@ type NodePoolSpec struct {
 	Quantity        int               `json:"quantity" norman:"required,default=1"`
 	NodeLabels      map[string]string `json:"nodeLabels"`
 	NodeAnnotations map[string]string `json:"nodeAnnotations"`
+	NodeTaints      []v1.Taint        `json:"nodeTaints,omitempty"`
 
 	DisplayName string `json:"displayName"`
 	ClusterName string `json:"clusterName,omitempty" norman:"type=reference[cluster],noupdate,required"`
@@ -186,6 +188,7 @@ type NodeSpec struct {
 	InternalNodeSpec       v1.NodeSpec       `json:"internalNodeSpec"`
 	DesiredNodeLabels      map[string]string `json:"desiredNodeLabels,omitempty"`
 	DesiredNodeAnnotations map[string]string `json:"desiredNodeAnnotations,omitempty"`
+	DesiredNodeTaints      []v1.Taint        `json:"desiredNodeTaints,omitempty"`
 }
 
 type NodeCommonParams struct {
@@ -0,0 +1,44 @@
+package v3
+
+import (
+	"github.com/rancher/norman/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type MonitoringConfig struct {
+	// Retention is how long samples are kept, in the duration format of prometheus such as 12h or 7d
+	Retention    string `json:"retention,omitempty" norman:"default=12h"`
+	StorageClass string `json:"storageClass,omitempty" norman:"type=reference[/v3/cluster/storageClass]"`
+	// StorageSize is the size of the volume claimed from StorageClass, samples are kept in memory without a storage class
+	StorageSize  string                  `json:"storageSize,omitempty" norman:"default=50Gi"`
+	Resources    *ContainerResourceLimit `json:"resources,omitempty"`
+	NodeSelector map[string]string       `json:"nodeSelector,omitempty"`
+}
+
+type MonitorMetric struct {
+	types.Namespaced
+
+	metav1.TypeMeta `json:",inline"`
+	// Standard object’s metadata. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MonitorMetricSpec `json:"spec"`
+}
+
+type MonitorMetricSpec struct {
+	// Expression is the prometheus query that produces the metric
+	Expression string `json:"expression,omitempty" norman:"required"`
+	// LegendFormat names the series of the metric in graphs, labels are referenced as {{label}}
+	LegendFormat string `json:"legendFormat,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+type MonitoringSystemImages struct {
+	Prometheus               string `json:"prometheus,omitempty"`
+	PrometheusConfigReloader string `json:"prometheusConfigReloader,omitempty"`
+	PrometheusOperator       string `json:"prometheusOperator,omitempty"`
+	NodeExporter             string `json:"nodeExporter,omitempty"`
+	KubeStateMetrics         string `json:"kubeStateMetrics,omitempty"`
+	Grafana                  string `json:"grafana,omitempty"`
+}
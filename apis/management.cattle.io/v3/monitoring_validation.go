@@ -0,0 +1,40 @@
+package v3
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var prometheusDurationRegexp = regexp.MustCompile(`^[0-9]+(ms|[smhdwy])$`)
+
+// Validate checks the monitoring config for values prometheus would not start with
+func (m *MonitoringConfig) Validate() ValidationResult {
+	result := ValidationResult{}
+
+	if m.Retention != "" && !prometheusDurationRegexp.MatchString(m.Retention) {
+		result.errorf("retention", "[%s] is not a valid duration, use a number followed by one of ms, s, m, h, d, w, y", m.Retention)
+	}
+	if m.StorageSize != "" {
+		if size, err := resource.ParseQuantity(m.StorageSize); err != nil || size.Sign() <= 0 {
+			result.errorf("storageSize", "[%s] is not a valid size", m.StorageSize)
+		}
+	}
+	if m.StorageClass == "" {
+		result.warnf("storageClass", "no storage class is set, samples are lost when prometheus restarts")
+	}
+	if m.Resources != nil {
+		result.merge("resources.", m.Resources.Validate())
+	}
+	for _, key := range sortedKeys(m.NodeSelector) {
+		for _, msg := range validation.IsQualifiedName(key) {
+			result.errorf("nodeSelector", "invalid key [%s]: %s", key, msg)
+		}
+		for _, msg := range validation.IsValidLabelValue(m.NodeSelector[key]) {
+			result.errorf("nodeSelector", "invalid value of [%s]: %s", key, msg)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,73 @@
+package v3
+
+import (
+	"fmt"
+
+	"k8s.io/api/core/v1"
+)
+
+// MergeTaints returns current with the taints of desired applied. A taint of desired replaces the taint of
+// current with the same key and effect. The taints of managed, the ones applied before and kept in
+// NodeStatus.AppliedNodeTaints, are removed when they are no longer desired, other taints of current are
+// kept.
+func MergeTaints(current, managed, desired []v1.Taint) []v1.Taint {
+	var result []v1.Taint
+	for _, taint := range current {
+		if findTaint(desired, taint) < 0 && findTaint(managed, taint) < 0 {
+			result = append(result, taint)
+		}
+	}
+	return append(result, desired...)
+}
+
+// TaintsApplied reports whether every taint of desired is set on current with the same value
+func TaintsApplied(current, desired []v1.Taint) bool {
+	for _, taint := range desired {
+		i := findTaint(current, taint)
+		if i < 0 || current[i].Value != taint.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateTaint returns an error if taint can not be set on a node
+func ValidateTaint(taint v1.Taint) error {
+	if taint.Key == "" {
+		return fmt.Errorf("taint key is required")
+	}
+	switch taint.Effect {
+	case v1.TaintEffectNoSchedule, v1.TaintEffectPreferNoSchedule, v1.TaintEffectNoExecute:
+	default:
+		return fmt.Errorf("unsupported effect [%s] of taint [%s], must be one of %s, %s or %s", taint.Effect, taint.Key,
+			v1.TaintEffectNoSchedule, v1.TaintEffectPreferNoSchedule, v1.TaintEffectNoExecute)
+	}
+	return nil
+}
+
+// Validate checks the taints the nodes of the pool are created with
+func (n *NodePoolSpec) Validate() ValidationResult {
+	result := ValidationResult{}
+	validateTaints("nodeTaints", n.NodeTaints, &result)
+	return result
+}
+
+func validateTaints(field string, taints []v1.Taint, result *ValidationResult) {
+	for i, taint := range taints {
+		taintField := fmt.Sprintf("%s[%d]", field, i)
+		if err := ValidateTaint(taint); err != nil {
+			result.errorf(taintField, "%v", err)
+		} else if j := findTaint(taints[:i], taint); j >= 0 {
+			result.errorf(taintField, "taint [%s:%s] is already set by %s[%d]", taint.Key, taint.Effect, field, j)
+		}
+	}
+}
+
+func findTaint(taints []v1.Taint, taint v1.Taint) int {
+	for i := range taints {
+		if taints[i].MatchTaint(&taint) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,87 @@
+package v3
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+)
+
+func TestMergeTaints(t *testing.T) {
+	dedicated := v1.Taint{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule}
+	dedicatedDB := v1.Taint{Key: "dedicated", Value: "db", Effect: v1.TaintEffectNoSchedule}
+	evict := v1.Taint{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoExecute}
+	notReady := v1.Taint{Key: "node.kubernetes.io/not-ready", Effect: v1.TaintEffectNoSchedule}
+
+	tests := []struct {
+		name     string
+		current  []v1.Taint
+		managed  []v1.Taint
+		desired  []v1.Taint
+		expected []v1.Taint
+	}{
+		{
+			name:     "added",
+			current:  []v1.Taint{notReady},
+			desired:  []v1.Taint{dedicated},
+			expected: []v1.Taint{notReady, dedicated},
+		},
+		{
+			name:     "value replaced",
+			current:  []v1.Taint{dedicated, notReady},
+			managed:  []v1.Taint{dedicated},
+			desired:  []v1.Taint{dedicatedDB},
+			expected: []v1.Taint{notReady, dedicatedDB},
+		},
+		{
+			name:     "same key with another effect",
+			current:  []v1.Taint{dedicated},
+			managed:  []v1.Taint{dedicated},
+			desired:  []v1.Taint{dedicated, evict},
+			expected: []v1.Taint{dedicated, evict},
+		},
+		{
+			name:     "no longer desired",
+			current:  []v1.Taint{notReady, dedicated, evict},
+			managed:  []v1.Taint{dedicated, evict},
+			desired:  []v1.Taint{evict},
+			expected: []v1.Taint{notReady, evict},
+		},
+		{
+			name:     "all removed",
+			current:  []v1.Taint{dedicated, notReady},
+			managed:  []v1.Taint{dedicated},
+			expected: []v1.Taint{notReady},
+		},
+		{
+			name:     "taints set by others are kept",
+			current:  []v1.Taint{notReady, dedicated},
+			expected: []v1.Taint{notReady, dedicated},
+		},
+	}
+
+	for _, test := range tests {
+		result := MergeTaints(test.current, test.managed, test.desired)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, result)
+		}
+		if !TaintsApplied(result, test.desired) {
+			t.Errorf("%s: expected the desired taints %v to be applied to %v", test.name, test.desired, result)
+		}
+	}
+}
+
+func TestNodePoolSpecValidate(t *testing.T) {
+	pool := &NodePoolSpec{NodeTaints: []v1.Taint{
+		{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule},
+		{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoExecute},
+		{Value: "gpu", Effect: v1.TaintEffectNoSchedule},
+		{Key: "dedicated", Value: "db", Effect: v1.TaintEffectNoSchedule},
+		{Key: "spot", Effect: "NoRun"},
+	}}
+
+	expected := []string{"nodeTaints[2]", "nodeTaints[3]", "nodeTaints[4]"}
+	if errors := fields(pool.Validate().Errors); !reflect.DeepEqual(errors, expected) {
+		t.Errorf("expected errors of %v, got %v", expected, pool.Validate().Errors)
+	}
+}
@@ -1,5 +1,7 @@
 package v3
 
+import v1 "k8s.io/api/core/v1"
+
 type RancherKubernetesEngineConfig struct {
 	// Kubernetes nodes
 	Nodes []RKEConfigNode `yaml:"nodes" json:"nodes,omitempty"`
@@ -39,6 +41,45 @@ type RancherKubernetesEngineConfig struct {
 	AddonJobTimeout int `yaml:"addon_job_timeout" json:"addonJobTimeout,omitempty" norman:"default=30"`
 	// Bastion/Jump Host configuration
 	BastionHost BastionHost `yaml:"bastion_host" json:"bastionHost,omitempty"`
+	// How nodes are upgraded when the kubernetes version or the node config changes
+	UpgradeStrategy *NodeUpgradeStrategy `yaml:"upgrade_strategy,omitempty" json:"upgradeStrategy,omitempty"`
+	// DNS provider and its configuration
+	DNS *DNSConfig `yaml:"dns,omitempty" json:"dns,omitempty"`
+}
+
+type DNSConfig struct {
+	// DNS provider of the cluster
+	Provider string `yaml:"provider" json:"provider,omitempty" norman:"default=kube-dns,options=kube-dns|coredns|none"`
+	// Nameservers queries outside of the cluster domain are forwarded to, defaults to the resolv.conf of the node
+	UpstreamNameservers []string `yaml:"upstreamnameservers" json:"upstreamnameservers,omitempty"`
+	// Nameservers of specific domains, keyed by domain
+	StubDomains map[string][]string `yaml:"stubdomains" json:"stubdomains,omitempty"`
+	// Node selector of the DNS pods
+	NodeSelector map[string]string `yaml:"node_selector" json:"nodeSelector,omitempty"`
+}
+
+type NodeUpgradeStrategy struct {
+	// Number (e.g. 1) or percentage (e.g. 10%) of worker nodes that can be unavailable at the same time
+	MaxUnavailable string `yaml:"max_unavailable" json:"maxUnavailable,omitempty" norman:"default=10%"`
+	// Drain nodes before they are upgraded
+	Drain bool `yaml:"drain" json:"drain,omitempty"`
+	// Drain options, used when Drain is enabled
+	DrainInput *NodeDrainInput `yaml:"node_drain_input,omitempty" json:"nodeDrainInput,omitempty"`
+	// Order in which the nodes of each role are upgraded (default: etcd, controlplane, worker)
+	RoleOrder []string `yaml:"role_order" json:"roleOrder,omitempty" norman:"type=array[enum],options=etcd|controlplane|worker"`
+}
+
+type NodeDrainInput struct {
+	// Drain pods not managed by a controller
+	Force bool `yaml:"force" json:"force,omitempty"`
+	// Ignore pods managed by a DaemonSet
+	IgnoreDaemonSets bool `yaml:"ignore_daemonsets" json:"ignoreDaemonSets,omitempty" norman:"default=true"`
+	// Delete pods using emptyDir, their data is lost
+	DeleteLocalData bool `yaml:"delete_local_data" json:"deleteLocalData,omitempty"`
+	// Seconds each pod is given to terminate, -1 uses the grace period of the pod
+	GracePeriod int `yaml:"grace_period" json:"gracePeriod,omitempty" norman:"default=-1"`
+	// Seconds to wait for the drain to finish before the upgrade of the node fails
+	Timeout int `yaml:"timeout" json:"timeout,omitempty" norman:"default=120,min=1,max=10800"`
 }
 
 type BastionHost struct {
@@ -51,7 +92,9 @@ type BastionHost struct {
 	// SSH Agent Auth enable
 	SSHAgentAuth bool `yaml:"ssh_agent_auth,omitempty" json:"sshAgentAuth,omitempty"`
 	// SSH Private Key
-	SSHKey string `yaml:"ssh_key" json:"sshKey,omitempty"`
+	SSHKey string `yaml:"ssh_key" json:"sshKey,omitempty" norman:"type=password"`
+	// Secret key holding the SSH Private Key, takes precedence over SSHKey
+	SSHKeySecretRef *SecretKeyRef `yaml:"ssh_key_secret_ref,omitempty" json:"sshKeySecretRef,omitempty"`
 	// SSH Private Key Path
 	SSHKeyPath string `yaml:"ssh_key_path" json:"sshKeyPath,omitempty"`
 }
@@ -62,7 +105,20 @@ type PrivateRegistry struct {
 	// User name for registry acces
 	User string `yaml:"user" json:"user,omitempty"`
 	// Password for registry access
-	Password string `yaml:"password" json:"password,omitempty"`
+	Password string `yaml:"password" json:"password,omitempty" norman:"type=password"`
+	// Secret key holding the password for registry access, takes precedence over Password
+	PasswordSecretRef *SecretKeyRef `yaml:"password_secret_ref,omitempty" json:"passwordSecretRef,omitempty"`
+}
+
+// SecretKeyRef refers to a key of a secret holding a credential, the credential is read when the plan
+// of the cluster is built and never stored in the cluster
+type SecretKeyRef struct {
+	// Namespace of the secret
+	Namespace string `yaml:"namespace" json:"namespace,omitempty" norman:"required"`
+	// Name of the secret
+	Name string `yaml:"name" json:"name,omitempty" norman:"required"`
+	// Key of the credential in the secret
+	Key string `yaml:"key" json:"key,omitempty" norman:"required"`
 }
 
 type RKESystemImages struct {
@@ -84,6 +140,10 @@ type RKESystemImages struct {
 	KubeDNSSidecar string `yaml:"kubedns_sidecar" json:"kubednsSidecar,omitempty"`
 	// KubeDNS autoscaler image
 	KubeDNSAutoscaler string `yaml:"kubedns_autoscaler" json:"kubednsAutoscaler,omitempty"`
+	// CoreDNS image
+	CoreDNS string `yaml:"coredns" json:"coredns,omitempty"`
+	// CoreDNS autoscaler image
+	CoreDNSAutoscaler string `yaml:"coredns_autoscaler" json:"corednsAutoscaler,omitempty"`
 	// Kubernetes image
 	Kubernetes string `yaml:"kubernetes" json:"kubernetes,omitempty"`
 	// Flannel image
@@ -136,11 +196,15 @@ type RKEConfigNode struct {
 	// SSH Agent Auth enable
 	SSHAgentAuth bool `yaml:"ssh_agent_auth,omitempty" json:"sshAgentAuth,omitempty"`
 	// SSH Private Key
-	SSHKey string `yaml:"ssh_key" json:"sshKey,omitempty"`
+	SSHKey string `yaml:"ssh_key" json:"sshKey,omitempty" norman:"type=password"`
+	// Secret key holding the SSH Private Key, takes precedence over SSHKey
+	SSHKeySecretRef *SecretKeyRef `yaml:"ssh_key_secret_ref,omitempty" json:"sshKeySecretRef,omitempty"`
 	// SSH Private Key Path
 	SSHKeyPath string `yaml:"ssh_key_path" json:"sshKeyPath,omitempty"`
 	// Node Labels
 	Labels map[string]string `yaml:"labels" json:"labels,omitempty"`
+	// Node Taints, set when the node registers
+	Taints []v1.Taint `yaml:"taints" json:"taints,omitempty"`
 }
 
 type RKEConfigServices struct {
@@ -177,6 +241,32 @@ type ETCDService struct {
 	Retention string `yaml:"retention" json:"retention,omitempty"`
 	// Etcd Backup Creation period
 	Creation string `yaml:"creation" json:"creation,omitempty"`
+	// Recurring etcd snapshots and where they are stored
+	BackupConfig *BackupConfig `yaml:"backup_config,omitempty" json:"backupConfig,omitempty"`
+}
+
+type BackupConfig struct {
+	// Enable or disable recurring snapshots
+	Enabled *bool `yaml:"enabled" json:"enabled,omitempty" norman:"default=true"`
+	// Interval in hours between snapshots
+	IntervalHours int `yaml:"interval_hours" json:"intervalHours,omitempty" norman:"default=12,min=1"`
+	// Number of snapshots to keep
+	Retention int `yaml:"retention" json:"retention,omitempty" norman:"default=6,min=1"`
+	// S3 compatible target the snapshots are uploaded to, snapshots stay on the etcd nodes if not set
+	S3BackupConfig *S3BackupConfig `yaml:"s3_backup_config,omitempty" json:"s3BackupConfig,omitempty"`
+}
+
+type S3BackupConfig struct {
+	// Name of the bucket
+	BucketName string `yaml:"bucket_name" json:"bucketName,omitempty" norman:"required"`
+	// Folder in the bucket the snapshots are stored in
+	Folder string `yaml:"folder" json:"folder,omitempty"`
+	// S3 compatible endpoint, defaults to AWS S3
+	Endpoint string `yaml:"endpoint" json:"endpoint,omitempty" norman:"default=s3.amazonaws.com"`
+	// Region of the bucket
+	Region string `yaml:"region" json:"region,omitempty"`
+	// Secret holding the accessKey and secretKey used to access the bucket
+	CredentialSecret *v1.SecretReference `yaml:"credential_secret,omitempty" json:"credentialSecret,omitempty"`
 }
 
 type KubeAPIService struct {
@@ -186,6 +276,58 @@ type KubeAPIService struct {
 	ServiceClusterIPRange string `yaml:"service_cluster_ip_range" json:"serviceClusterIpRange,omitempty"`
 	// Enabled/Disable PodSecurityPolicy
 	PodSecurityPolicy bool `yaml:"pod_security_policy" json:"podSecurityPolicy,omitempty"`
+	// Audit log of the api server
+	AuditLog *AuditLog `yaml:"audit_log,omitempty" json:"auditLog,omitempty"`
+	// Encryption of secrets at rest
+	SecretsEncryptionConfig *SecretsEncryptionConfig `yaml:"secrets_encryption_config,omitempty" json:"secretsEncryptionConfig,omitempty"`
+}
+
+type AuditLog struct {
+	// Enable the audit log
+	Enabled bool `yaml:"enabled" json:"enabled,omitempty"`
+	// Audit policy document in yaml, defaults to logging the metadata of every request
+	Policy string `yaml:"policy" json:"policy,omitempty"`
+	// Path of the log on the control plane nodes
+	Path string `yaml:"path" json:"path,omitempty" norman:"default=/var/log/kube-audit/audit-log.json"`
+	// Days an old log file is kept
+	MaxAge int `yaml:"max_age" json:"maxAge,omitempty" norman:"default=30"`
+	// Number of old log files kept
+	MaxBackup int `yaml:"max_backup" json:"maxBackup,omitempty" norman:"default=10"`
+	// Size in megabytes at which the log file is rotated
+	MaxSize int `yaml:"max_size" json:"maxSize,omitempty" norman:"default=100"`
+	// Format of the log lines
+	Format string `yaml:"format" json:"format,omitempty" norman:"default=json,options=json|legacy"`
+}
+
+type SecretsEncryptionConfig struct {
+	// Enable encryption of secrets at rest
+	Enabled bool `yaml:"enabled" json:"enabled,omitempty"`
+	// Encryption providers in order, the first one encrypts new secrets and every one of them decrypts
+	Providers []EncryptionProvider `yaml:"providers" json:"providers,omitempty"`
+	// Rotation of the encryption key
+	KeyRotation *KeyRotation `yaml:"key_rotation,omitempty" json:"keyRotation,omitempty"`
+}
+
+type EncryptionProvider struct {
+	// Type of the provider, identity stores secrets unencrypted
+	Type string `yaml:"type" json:"type,omitempty" norman:"required,options=aescbc|aesgcm|secretbox|identity"`
+	// Keys of the provider, the first key encrypts new secrets
+	Keys []EncryptionKey `yaml:"keys" json:"keys,omitempty"`
+}
+
+type EncryptionKey struct {
+	// Name of the key
+	Name string `yaml:"name" json:"name,omitempty" norman:"required"`
+	// Base64 encoded key
+	Secret string `yaml:"secret" json:"secret,omitempty" norman:"required,type=password"`
+}
+
+type KeyRotation struct {
+	// Name of the key of the first provider that encrypts new secrets, set it to a newly added key to rotate.
+	// Old keys keep decrypting until they are removed.
+	ActiveKey string `yaml:"active_key" json:"activeKey,omitempty"`
+	// Rewrite every secret with the active key once it is deployed, so that old keys can be removed
+	RewriteSecrets bool `yaml:"rewrite_secrets" json:"rewriteSecrets,omitempty"`
 }
 
 type KubeControllerService struct {
@@ -287,6 +429,8 @@ type RKEConfigNodePlan struct {
 	Annotations map[string]string `json:"annotations,omitempty"`
 	// Node Labels
 	Labels map[string]string `json:"labels,omitempty"`
+	// Node Taints
+	Taints []v1.Taint `json:"taints,omitempty"`
 }
 
 type Process struct {
@@ -320,6 +464,39 @@ type Process struct {
 	Labels map[string]string `json:"labels,omitempty"`
 }
 
+type NodePlanChange struct {
+	// Node address
+	Address string `json:"address,omitempty"`
+	// create if the node is added to the plan, remove if it is dropped from it
+	Action string `json:"action,omitempty" norman:"options=create|update|remove"`
+	// Processes that are started, restarted or stopped on the node
+	Processes []ProcessChange `json:"processes,omitempty"`
+	// Names of files that are added, changed or removed on the node
+	AddedFiles   []string `json:"addedFiles,omitempty"`
+	ChangedFiles []string `json:"changedFiles,omitempty"`
+	RemovedFiles []string `json:"removedFiles,omitempty"`
+}
+
+type ProcessChange struct {
+	// Process name, this should be the container name
+	Name string `json:"name,omitempty"`
+	// update means the container is recreated
+	Action string `json:"action,omitempty" norman:"options=create|update|remove"`
+	// Fields that changed for an updated process
+	Fields []ProcessFieldChange `json:"fields,omitempty"`
+}
+
+type ProcessFieldChange struct {
+	// Name of the changed field of the process
+	Field string `json:"field,omitempty"`
+	// Old and new value of single value fields
+	Old string `json:"old,omitempty"`
+	New string `json:"new,omitempty"`
+	// Entries added to and removed from list and map fields
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
 type HealthCheck struct {
 	// Healthcheck URL
 	URL string `json:"url,omitempty"`
@@ -343,6 +520,10 @@ type CloudProvider struct {
 	AWSCloudProvider *AWSCloudProvider `yaml:",omitempty" json:"awsCloudProvider,omitempty"`
 	// AzureCloudProvicer
 	AzureCloudProvider *AzureCloudProvider `yaml:",omitempty" json:"azureCloudProvider,omitempty"`
+	// VsphereCloudProvider
+	VsphereCloudProvider *VsphereCloudProvider `yaml:"vsphereCloudProvider,omitempty" json:"vsphereCloudProvider,omitempty"`
+	// OpenstackCloudProvider
+	OpenstackCloudProvider *OpenstackCloudProvider `yaml:"openstackCloudProvider,omitempty" json:"openstackCloudProvider,omitempty"`
 }
 
 type AzureCloudProvider struct {
@@ -384,11 +565,15 @@ type AzureCloudProvider struct {
 	// The ClientID for an AAD application with RBAC access to talk to Azure RM APIs
 	AADClientID string `json:"aadClientId" yaml:"aadClientId"`
 	// The ClientSecret for an AAD application with RBAC access to talk to Azure RM APIs
-	AADClientSecret string `json:"aadClientSecret" yaml:"aadClientSecret"`
+	AADClientSecret string `json:"aadClientSecret" yaml:"aadClientSecret" norman:"type=password"`
+	// Secret key holding the AADClientSecret, takes precedence over AADClientSecret
+	AADClientSecretRef *SecretKeyRef `json:"aadClientSecretRef,omitempty" yaml:"aadClientSecretRef,omitempty"`
 	// The path of a client certificate for an AAD application with RBAC access to talk to Azure RM APIs
 	AADClientCertPath string `json:"aadClientCertPath" yaml:"aadClientCertPath"`
 	// The password of the client certificate for an AAD application with RBAC access to talk to Azure RM APIs
-	AADClientCertPassword string `json:"aadClientCertPassword" yaml:"aadClientCertPassword"`
+	AADClientCertPassword string `json:"aadClientCertPassword" yaml:"aadClientCertPassword" norman:"type=password"`
+	// Secret key holding the AADClientCertPassword, takes precedence over AADClientCertPassword
+	AADClientCertPasswordRef *SecretKeyRef `json:"aadClientCertPasswordRef,omitempty" yaml:"aadClientCertPasswordRef,omitempty"`
 	// Enable exponential backoff to manage resource request retries
 	CloudProviderBackoff bool `json:"cloudProviderBackoff" yaml:"cloudProviderBackoff"`
 	// Backoff retry limit
@@ -414,6 +599,209 @@ type AzureCloudProvider struct {
 }
 
 type AWSCloudProvider struct {
+	// Global options of the provider
+	Global GlobalAwsOpts `yaml:"global" json:"global,omitempty" ini:"Global"`
+	// Custom endpoints for AWS services, keyed by an arbitrary unique name
+	ServiceOverride map[string]ServiceOverride `yaml:"service_override" json:"serviceOverride,omitempty" ini:"ServiceOverride"`
+}
+
+type GlobalAwsOpts struct {
+	// Availability zone of the cluster, defaults to the zone of the instance
+	Zone string `yaml:"zone" json:"zone,omitempty" ini:"Zone"`
+	// ID of the VPC the cluster runs in
+	VPC string `yaml:"vpc" json:"vpc,omitempty" ini:"VPC"`
+	// ID of the subnet load balancers are created in, required when VPC is set
+	SubnetID string `yaml:"subnet_id" json:"subnetId,omitempty" ini:"SubnetID"`
+	// ID of the route table that routes for the pod CIDRs are added to
+	RouteTableID string `yaml:"route_table_id" json:"routeTableId,omitempty" ini:"RouteTableID"`
+	// IAM role assumed for all calls to AWS
+	RoleARN string `yaml:"role_arn" json:"roleArn,omitempty" ini:"RoleARN"`
+	// Legacy cluster id used to tag resources
+	KubernetesClusterTag string `yaml:"kubernetes_cluster_tag" json:"kubernetesClusterTag,omitempty" ini:"KubernetesClusterTag"`
+	// Cluster id used to tag resources owned by the cluster
+	KubernetesClusterID string `yaml:"kubernetes_cluster_id" json:"kubernetesClusterId,omitempty" ini:"KubernetesClusterID"`
+	// Do not add ingress rules to the security groups of the nodes for load balancers
+	DisableSecurityGroupIngress bool `yaml:"disable_security_group_ingress" json:"disableSecurityGroupIngress,omitempty" ini:"DisableSecurityGroupIngress"`
+	// Security group attached to every load balancer instead of creating one per load balancer
+	ElbSecurityGroup string `yaml:"elb_security_group" json:"elbSecurityGroup,omitempty" ini:"ElbSecurityGroup"`
+	// Allow nodes outside of the zone of the cluster
+	DisableStrictZoneCheck bool `yaml:"disable_strict_zone_check" json:"disableStrictZoneCheck,omitempty" ini:"DisableStrictZoneCheck"`
+}
+
+type ServiceOverride struct {
+	// AWS service, for example ec2 or elasticloadbalancing
+	Service string `yaml:"service" json:"service,omitempty" ini:"Service"`
+	// Region the endpoint is used for
+	Region string `yaml:"region" json:"region,omitempty" ini:"Region"`
+	// Endpoint URL
+	URL string `yaml:"url" json:"url,omitempty" ini:"URL"`
+	// Region used to sign requests
+	SigningRegion string `yaml:"signing_region" json:"signingRegion,omitempty" ini:"SigningRegion"`
+	// Signing method, for example v4
+	SigningMethod string `yaml:"signing_method" json:"signingMethod,omitempty" ini:"SigningMethod"`
+	// Service name used to sign requests
+	SigningName string `yaml:"signing_name" json:"signingName,omitempty" ini:"SigningName"`
+}
+
+type VsphereCloudProvider struct {
+	// Global options, also the defaults of every virtual center
+	Global GlobalVsphereOpts `yaml:"global" json:"global,omitempty" ini:"Global"`
+	// Virtual centers keyed by their address
+	VirtualCenter map[string]VirtualCenterConfig `yaml:"virtual_center" json:"virtualCenter,omitempty" ini:"VirtualCenter"`
+	// Network options
+	Network NetworkVsphereOpts `yaml:"network" json:"network,omitempty" ini:"Network"`
+	// Disk options
+	Disk DiskVsphereOpts `yaml:"disk" json:"disk,omitempty" ini:"Disk"`
+	// Location volumes and node VMs are looked up in
+	Workspace WorkspaceVsphereOpts `yaml:"workspace" json:"workspace,omitempty" ini:"Workspace"`
+}
+
+type GlobalVsphereOpts struct {
+	// Default user of the virtual centers
+	User string `yaml:"user" json:"user,omitempty" ini:"user"`
+	// Default password of the virtual centers
+	Password string `yaml:"password" json:"password,omitempty" ini:"password" norman:"type=password"`
+	// Secret key holding the default password, takes precedence over Password
+	PasswordSecretRef *SecretKeyRef `yaml:"password_secret_ref,omitempty" json:"passwordSecretRef,omitempty"`
+	// Address of the virtual center, deprecated in favour of VirtualCenter
+	VCenterIP string `yaml:"server" json:"server,omitempty" ini:"server"`
+	// Default port of the virtual centers
+	VCenterPort string `yaml:"port" json:"port,omitempty" ini:"port"`
+	// Do not verify the certificates of the virtual centers
+	InsecureFlag bool `yaml:"insecure_flag" json:"insecureFlag,omitempty" ini:"insecure-flag"`
+	// Datacenter, deprecated in favour of Datacenters
+	Datacenter string `yaml:"datacenter" json:"datacenter,omitempty" ini:"datacenter"`
+	// Comma separated default list of datacenters
+	Datacenters string `yaml:"datacenters" json:"datacenters,omitempty" ini:"datacenters"`
+	// Datastore, deprecated in favour of Workspace
+	DefaultDatastore string `yaml:"datastore" json:"datastore,omitempty" ini:"datastore"`
+	// Folder of the node VMs, deprecated in favour of Workspace
+	WorkingDir string `yaml:"working_dir" json:"workingDir,omitempty" ini:"working-dir"`
+	// Number of retries of SOAP calls
+	RoundTripperCount int `yaml:"soap_roundtrip_count" json:"soapRoundtripCount,omitempty" ini:"soap-roundtrip-count"`
+	// UUID of the VM of the node
+	VMUUID string `yaml:"vm_uuid" json:"vmUuid,omitempty" ini:"vm-uuid"`
+	// Name of the VM of the node
+	VMName string `yaml:"vm_name" json:"vmName,omitempty" ini:"vm-name"`
+}
+
+type VirtualCenterConfig struct {
+	// User of the virtual center
+	User string `yaml:"user" json:"user,omitempty" ini:"user"`
+	// Password of the virtual center
+	Password string `yaml:"password" json:"password,omitempty" ini:"password" norman:"type=password"`
+	// Secret key holding the password, takes precedence over Password
+	PasswordSecretRef *SecretKeyRef `yaml:"password_secret_ref,omitempty" json:"passwordSecretRef,omitempty"`
+	// Port of the virtual center
+	VCenterPort string `yaml:"port" json:"port,omitempty" ini:"port"`
+	// Comma separated list of datacenters the node VMs can be in
+	Datacenters string `yaml:"datacenters" json:"datacenters,omitempty" ini:"datacenters"`
+	// Number of retries of SOAP calls
+	RoundTripperCount int `yaml:"soap_roundtrip_count" json:"soapRoundtripCount,omitempty" ini:"soap-roundtrip-count"`
+}
+
+type NetworkVsphereOpts struct {
+	// Name of the network the external address of the nodes is taken from
+	PublicNetwork string `yaml:"public_network" json:"publicNetwork,omitempty" ini:"public-network"`
+}
+
+type DiskVsphereOpts struct {
+	// SCSI controller type used for volumes
+	SCSIControllerType string `yaml:"scsicontrollertype" json:"scsiControllerType,omitempty" ini:"scsicontrollertype"`
+}
+
+type WorkspaceVsphereOpts struct {
+	// Address of the virtual center volumes are created in
+	VCenterIP string `yaml:"server" json:"server,omitempty" ini:"server"`
+	// Datacenter volumes are created in
+	Datacenter string `yaml:"datacenter" json:"datacenter,omitempty" ini:"datacenter"`
+	// Folder of the node VMs
+	Folder string `yaml:"folder" json:"folder,omitempty" ini:"folder"`
+	// Datastore volumes are created in
+	DefaultDatastore string `yaml:"default_datastore" json:"defaultDatastore,omitempty" ini:"default-datastore"`
+	// Resource pool dummy VMs for volume provisioning are created in
+	ResourcePoolPath string `yaml:"resourcepool_path" json:"resourcepoolPath,omitempty" ini:"resourcepool-path"`
+}
+
+type OpenstackCloudProvider struct {
+	// Authentication options
+	Global GlobalOpenstackOpts `yaml:"global" json:"global,omitempty" ini:"Global"`
+	// Load balancer (neutron LBaaS or octavia) options
+	LoadBalancer LoadBalancerOpenstackOpts `yaml:"load_balancer" json:"loadBalancer,omitempty" ini:"LoadBalancer"`
+	// Block storage (cinder) options
+	BlockStorage BlockStorageOpenstackOpts `yaml:"block_storage" json:"blockStorage,omitempty" ini:"BlockStorage"`
+	// Route options
+	Route RouteOpenstackOpts `yaml:"route" json:"route,omitempty" ini:"Route"`
+	// Metadata options
+	Metadata MetadataOpenstackOpts `yaml:"metadata" json:"metadata,omitempty" ini:"Metadata"`
+}
+
+type GlobalOpenstackOpts struct {
+	// Keystone URL
+	AuthURL string `yaml:"auth_url" json:"authUrl,omitempty" ini:"auth-url"`
+	// Keystone user, either Username or UserID is required
+	Username string `yaml:"username" json:"username,omitempty" ini:"username"`
+	UserID   string `yaml:"user_id" json:"userId,omitempty" ini:"user-id"`
+	// Keystone password
+	Password string `yaml:"password" json:"password,omitempty" ini:"password" norman:"type=password"`
+	// Secret key holding the keystone password, takes precedence over Password
+	PasswordSecretRef *SecretKeyRef `yaml:"password_secret_ref,omitempty" json:"passwordSecretRef,omitempty"`
+	// Project of the cluster, either TenantID or TenantName is required
+	TenantID   string `yaml:"tenant_id" json:"tenantId,omitempty" ini:"tenant-id"`
+	TenantName string `yaml:"tenant_name" json:"tenantName,omitempty" ini:"tenant-name"`
+	// Trust used for authentication instead of the password
+	TrustID string `yaml:"trust_id" json:"trustId,omitempty" ini:"trust-id"`
+	// Domain of the user
+	DomainID   string `yaml:"domain_id" json:"domainId,omitempty" ini:"domain-id"`
+	DomainName string `yaml:"domain_name" json:"domainName,omitempty" ini:"domain-name"`
+	// Region of the cluster
+	Region string `yaml:"region" json:"region,omitempty" ini:"region"`
+	// Path of the CA bundle on the nodes used to verify keystone
+	CAFile string `yaml:"ca_file" json:"caFile,omitempty" ini:"ca-file"`
+}
+
+type LoadBalancerOpenstackOpts struct {
+	// LBaaS version, only v2 is supported
+	LBVersion string `yaml:"lb_version" json:"lbVersion,omitempty" ini:"lb-version"`
+	// Use octavia instead of neutron LBaaS
+	UseOctavia bool `yaml:"use_octavia" json:"useOctavia,omitempty" ini:"use-octavia"`
+	// Subnet the load balancers are created in
+	SubnetID string `yaml:"subnet_id" json:"subnetId,omitempty" ini:"subnet-id"`
+	// Network floating IPs of load balancers are allocated from
+	FloatingNetworkID string `yaml:"floating_network_id" json:"floatingNetworkId,omitempty" ini:"floating-network-id"`
+	// Load balancing algorithm, defaults to ROUND_ROBIN
+	LBMethod string `yaml:"lb_method" json:"lbMethod,omitempty" ini:"lb-method"`
+	// Load balancer provider
+	LBProvider string `yaml:"lb_provider" json:"lbProvider,omitempty" ini:"lb-provider"`
+	// Create health monitors for the load balancers
+	CreateMonitor bool `yaml:"create_monitor" json:"createMonitor,omitempty" ini:"create-monitor"`
+	// Health monitor delay, timeout and retries, required when CreateMonitor is set
+	MonitorDelay      string `yaml:"monitor_delay" json:"monitorDelay,omitempty" ini:"monitor-delay"`
+	MonitorTimeout    string `yaml:"monitor_timeout" json:"monitorTimeout,omitempty" ini:"monitor-timeout"`
+	MonitorMaxRetries int    `yaml:"monitor_max_retries" json:"monitorMaxRetries,omitempty" ini:"monitor-max-retries"`
+	// Manage the security groups of the nodes for load balancers
+	ManageSecurityGroups bool `yaml:"manage_security_groups" json:"manageSecurityGroups,omitempty" ini:"manage-security-groups"`
+}
+
+type BlockStorageOpenstackOpts struct {
+	// Cinder API version, one of v1, v2, v3 or auto
+	BSVersion string `yaml:"bs_version" json:"bsVersion,omitempty" ini:"bs-version"`
+	// Trust the device path cinder reports for attached volumes
+	TrustDevicePath bool `yaml:"trust_device_path" json:"trustDevicePath,omitempty" ini:"trust-device-path"`
+	// Ignore the availability zone of volumes when attaching them
+	IgnoreVolumeAZ bool `yaml:"ignore_volume_az" json:"ignoreVolumeAz,omitempty" ini:"ignore-volume-az"`
+}
+
+type RouteOpenstackOpts struct {
+	// Router the routes for the pod CIDRs are added to
+	RouterID string `yaml:"router_id" json:"routerId,omitempty" ini:"router-id"`
+}
+
+type MetadataOpenstackOpts struct {
+	// Comma separated order of configDrive and metadataService used to look up instance metadata
+	SearchOrder string `yaml:"search_order" json:"searchOrder,omitempty" ini:"search-order"`
+	// Timeout of metadata requests, for example 5s
+	RequestTimeout string `yaml:"request_timeout" json:"requestTimeout,omitempty" ini:"request-timeout"`
 }
 
 type CalicoNetworkProvider struct {
@@ -0,0 +1,64 @@
+package v3
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// DefaultMaxUnavailable is the number of workers upgraded at the same time if NodeUpgradeStrategy.MaxUnavailable
+// is empty, it matches the default of the field
+const DefaultMaxUnavailable = "10%"
+
+// DefaultUpgradeRoleOrder is the order nodes are upgraded in if NodeUpgradeStrategy.RoleOrder is empty
+var DefaultUpgradeRoleOrder = []string{ETCDRole, ControlPlaneRole, WorkerRole}
+
+// UpgradeRoleOrder returns the order the nodes of each role are upgraded in
+func (n *NodeUpgradeStrategy) UpgradeRoleOrder() []string {
+	if n == nil || len(n.RoleOrder) == 0 {
+		return DefaultUpgradeRoleOrder
+	}
+	return n.RoleOrder
+}
+
+// MaxUnavailableWorkers returns how many of the given number of workers can be upgraded at the same time.
+// Percentages are rounded down, but at least one worker is always upgraded, also when MaxUnavailable is 0.
+// An empty MaxUnavailable is DefaultMaxUnavailable.
+func (n *NodeUpgradeStrategy) MaxUnavailableWorkers(workers int) (int, error) {
+	maxUnavailable := DefaultMaxUnavailable
+	if n != nil && n.MaxUnavailable != "" {
+		maxUnavailable = n.MaxUnavailable
+	}
+
+	var result int
+	if strings.HasSuffix(maxUnavailable, "%") {
+		percent, err := strconv.Atoi(strings.TrimSuffix(maxUnavailable, "%"))
+		if err != nil || percent < 0 || percent > 100 {
+			return 0, fmt.Errorf("invalid maxUnavailable [%s], percentage must be between 0%% and 100%%", maxUnavailable)
+		}
+		result = int(math.Floor(float64(workers) * float64(percent) / 100))
+	} else {
+		count, err := strconv.Atoi(maxUnavailable)
+		if err != nil || count < 0 {
+			return 0, fmt.Errorf("invalid maxUnavailable [%s], must be a non-negative number or a percentage", maxUnavailable)
+		}
+		result = count
+	}
+
+	if result < 1 {
+		result = 1
+	}
+	if workers > 0 && result > workers {
+		result = workers
+	}
+	return result, nil
+}
+
+// RollbackSpec returns the spec the rollback action restores, the last spec that was successfully applied
+func (c *Cluster) RollbackSpec() (*ClusterSpec, error) {
+	if c.Status.AppliedSpec.RancherKubernetesEngineConfig == nil {
+		return nil, fmt.Errorf("cluster %s has no applied rancher kubernetes engine config to roll back to", c.Name)
+	}
+	return c.Status.AppliedSpec.DeepCopy(), nil
+}
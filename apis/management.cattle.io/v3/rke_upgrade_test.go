@@ -0,0 +1,37 @@
+package v3
+
+import "testing"
+
+func TestMaxUnavailableWorkers(t *testing.T) {
+	tests := []struct {
+		strategy *NodeUpgradeStrategy
+		workers  int
+		expected int
+	}{
+		{nil, 50, 5},
+		{&NodeUpgradeStrategy{}, 50, 5},
+		{&NodeUpgradeStrategy{}, 5, 1},
+		{&NodeUpgradeStrategy{MaxUnavailable: "25%"}, 10, 2},
+		{&NodeUpgradeStrategy{MaxUnavailable: "0%"}, 10, 1},
+		{&NodeUpgradeStrategy{MaxUnavailable: "0"}, 10, 1},
+		{&NodeUpgradeStrategy{MaxUnavailable: "3"}, 10, 3},
+		{&NodeUpgradeStrategy{MaxUnavailable: "30"}, 10, 10},
+	}
+
+	for _, test := range tests {
+		result, err := test.strategy.MaxUnavailableWorkers(test.workers)
+		if err != nil {
+			t.Errorf("%+v: unexpected error %v", test.strategy, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("%+v of %d workers: expected %d, got %d", test.strategy, test.workers, test.expected, result)
+		}
+	}
+
+	for _, value := range []string{"110%", "-1", "ten"} {
+		if _, err := (&NodeUpgradeStrategy{MaxUnavailable: value}).MaxUnavailableWorkers(10); err == nil {
+			t.Errorf("expected an error for maxUnavailable [%s]", value)
+		}
+	}
+}
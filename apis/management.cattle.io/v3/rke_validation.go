@@ -0,0 +1,760 @@
+package v3
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	ETCDRole         = "etcd"
+	ControlPlaneRole = "controlplane"
+	WorkerRole       = "worker"
+)
+
+var (
+	// RKENetworkPlugins are the values accepted in NetworkConfig.Plugin, empty selects the default plugin
+	RKENetworkPlugins = []string{"flannel", "calico", "canal", "weave", "none"}
+	// RKEIngressProviders are the values accepted in IngressConfig.Provider
+	RKEIngressProviders = []string{"nginx", "none"}
+	// RKEDNSProviders are the values accepted in DNSConfig.Provider, empty selects kube-dns
+	RKEDNSProviders = []string{"kube-dns", "coredns", "none"}
+	// RKEAuthnStrategies are the values accepted in AuthnConfig.Strategy
+	RKEAuthnStrategies = []string{"x509"}
+	// RKEAuthzModes are the values accepted in AuthzConfig.Mode
+	RKEAuthzModes = []string{"rbac", "none"}
+
+	s3BucketRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+	s3RegionRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+)
+
+// FieldError describes a problem with a single field. Field is the path of the field relative to the
+// validated object and uses the json names of the fields, such as nodes[0].role.
+type FieldError struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func (f FieldError) Error() string {
+	if f.Field == "" {
+		return f.Message
+	}
+	return f.Field + ": " + f.Message
+}
+
+// ValidationResult holds every error and warning found while validating a config. Errors will make
+// provisioning fail, warnings are legal configurations that are most likely a mistake.
+type ValidationResult struct {
+	Errors   []FieldError `json:"errors,omitempty"`
+	Warnings []FieldError `json:"warnings,omitempty"`
+}
+
+func (v *ValidationResult) errorf(field, format string, args ...interface{}) {
+	v.Errors = append(v.Errors, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *ValidationResult) warnf(field, format string, args ...interface{}) {
+	v.Warnings = append(v.Warnings, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// merge adds the errors and warnings of other with their fields prefixed by prefix
+func (v *ValidationResult) merge(prefix string, other ValidationResult) {
+	for _, e := range other.Errors {
+		v.errorf(prefix+e.Field, "%s", e.Message)
+	}
+	for _, w := range other.Warnings {
+		v.warnf(prefix+w.Field, "%s", w.Message)
+	}
+}
+
+// Err returns nil if no errors were found, otherwise a single error listing all of them
+func (v ValidationResult) Err() error {
+	if len(v.Errors) == 0 {
+		return nil
+	}
+	var msgs []string
+	for _, err := range v.Errors {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Errorf("%s", strings.Join(msgs, "; "))
+}
+
+// Validate checks the config for mistakes that would otherwise only show up while provisioning the cluster
+func (r *RancherKubernetesEngineConfig) Validate() ValidationResult {
+	result := ValidationResult{}
+
+	r.validateNodes(&result)
+	r.validateServices(&result)
+	r.validateNetwork(&result)
+	r.validateBastionHost(&result)
+
+	if r.Authentication.Strategy != "" && !contains(RKEAuthnStrategies, r.Authentication.Strategy) {
+		result.errorf("authentication.strategy", "unsupported strategy [%s], must be one of %v", r.Authentication.Strategy, RKEAuthnStrategies)
+	}
+	if r.Authorization.Mode != "" && !contains(RKEAuthzModes, r.Authorization.Mode) {
+		result.errorf("authorization.mode", "unsupported mode [%s], must be one of %v", r.Authorization.Mode, RKEAuthzModes)
+	}
+	if r.Ingress.Provider != "" && !contains(RKEIngressProviders, r.Ingress.Provider) {
+		result.errorf("ingress.provider", "unsupported provider [%s], must be one of %v", r.Ingress.Provider, RKEIngressProviders)
+	}
+	if r.Version != "" && r.SystemImages.Kubernetes == "" {
+		if _, ok := K8sVersionToRKESystemImages[r.Version]; !ok {
+			result.warnf("kubernetesVersion", "unknown kubernetes version [%s], no default system images exist for it", r.Version)
+		}
+	}
+	if r.AddonJobTimeout < 0 {
+		result.errorf("addonJobTimeout", "must not be negative")
+	}
+	for i, registry := range r.PrivateRegistries {
+		path := fmt.Sprintf("privateRegistries[%d]", i)
+		if registry.URL == "" {
+			result.errorf(path+".url", "registry url is required")
+		}
+		if registry.User != "" && registry.Password == "" && registry.PasswordSecretRef == nil {
+			result.warnf(path+".password", "user [%s] is set without a password", registry.User)
+		}
+		validateSecretKeyRef(path+".passwordSecretRef", registry.PasswordSecretRef, &result)
+	}
+	r.validateCloudProvider(&result)
+	r.validateUpgradeStrategy(&result)
+	r.validateDNS(&result)
+
+	return result
+}
+
+func (r *RancherKubernetesEngineConfig) validateNodes(result *ValidationResult) {
+	if len(r.Nodes) == 0 {
+		result.warnf("nodes", "no nodes defined")
+		return
+	}
+
+	roleCount := map[string]int{}
+	addresses := map[string]int{}
+	for i, node := range r.Nodes {
+		path := fmt.Sprintf("nodes[%d]", i)
+
+		if node.Address == "" {
+			result.errorf(path+".address", "address is required")
+		} else if j, ok := addresses[node.Address]; ok {
+			result.errorf(path+".address", "address [%s] is already used by nodes[%d]", node.Address, j)
+		} else {
+			addresses[node.Address] = i
+		}
+
+		if len(node.Role) == 0 {
+			result.errorf(path+".role", "at least one role is required")
+		}
+		for _, role := range node.Role {
+			switch role {
+			case ETCDRole, ControlPlaneRole, WorkerRole:
+				roleCount[role]++
+			default:
+				result.errorf(path+".role", "unknown role [%s]", role)
+			}
+		}
+
+		// nodes provisioned through a node driver get their keys from rancher
+		if node.NodeName == "" && !node.SSHAgentAuth && !r.SSHAgentAuth &&
+			node.SSHKey == "" && node.SSHKeySecretRef == nil && node.SSHKeyPath == "" && r.SSHKeyPath == "" {
+			result.errorf(path+".sshKey", "sshKey and sshKeyPath are empty and ssh agent auth is disabled")
+		}
+		validateSecretKeyRef(path+".sshKeySecretRef", node.SSHKeySecretRef, result)
+
+		validateTaints(path+".taints", node.Taints, result)
+	}
+
+	if roleCount[ETCDRole] == 0 && len(r.Services.Etcd.ExternalURLs) == 0 {
+		result.errorf("nodes", "at least one node with the %s role is required", ETCDRole)
+	} else if roleCount[ETCDRole]%2 == 0 && roleCount[ETCDRole] > 0 {
+		result.warnf("nodes", "%d %s nodes tolerate no more failures than %d, use an odd number", roleCount[ETCDRole], ETCDRole, roleCount[ETCDRole]-1)
+	}
+	if roleCount[ControlPlaneRole] == 0 {
+		result.errorf("nodes", "at least one node with the %s role is required", ControlPlaneRole)
+	}
+	if roleCount[WorkerRole] == 0 {
+		result.errorf("nodes", "at least one node with the %s role is required", WorkerRole)
+	}
+}
+
+func (r *RancherKubernetesEngineConfig) validateServices(result *ValidationResult) {
+	etcd := r.Services.Etcd
+	if len(etcd.ExternalURLs) > 0 {
+		if etcd.CACert == "" {
+			result.errorf("services.etcd.caCert", "required when externalUrls are set")
+		}
+		if etcd.Cert == "" {
+			result.errorf("services.etcd.cert", "required when externalUrls are set")
+		}
+		if etcd.Key == "" {
+			result.errorf("services.etcd.key", "required when externalUrls are set")
+		}
+	}
+
+	if backup := etcd.BackupConfig; backup != nil {
+		if etcd.Backup || etcd.Retention != "" || etcd.Creation != "" {
+			result.warnf("services.etcd.backupConfig", "backup, retention and creation are ignored when backupConfig is set")
+		}
+		if backup.IntervalHours < 0 {
+			result.errorf("services.etcd.backupConfig.intervalHours", "must not be negative")
+		}
+		if backup.Retention < 0 {
+			result.errorf("services.etcd.backupConfig.retention", "must not be negative")
+		}
+		if s3 := backup.S3BackupConfig; s3 != nil {
+			result.merge("services.etcd.backupConfig.s3BackupConfig.", s3.Validate())
+		}
+	}
+
+	apiServiceRange := parseCIDR(result, "services.kubeApi.serviceClusterIpRange", r.Services.KubeAPI.ServiceClusterIPRange)
+	controllerServiceRange := parseCIDR(result, "services.kubeController.serviceClusterIpRange", r.Services.KubeController.ServiceClusterIPRange)
+	clusterCIDR := parseCIDR(result, "services.kubeController.clusterCidr", r.Services.KubeController.ClusterCIDR)
+
+	if apiServiceRange != nil && controllerServiceRange != nil && apiServiceRange.String() != controllerServiceRange.String() {
+		result.errorf("services.kubeController.serviceClusterIpRange", "[%s] does not match services.kubeApi.serviceClusterIpRange [%s]",
+			controllerServiceRange, apiServiceRange)
+	}
+
+	serviceRange := apiServiceRange
+	if serviceRange == nil {
+		serviceRange = controllerServiceRange
+	}
+	if serviceRange != nil && clusterCIDR != nil && overlaps(serviceRange, clusterCIDR) {
+		result.errorf("services.kubeController.clusterCidr", "[%s] overlaps with the service cluster ip range [%s]", clusterCIDR, serviceRange)
+	}
+
+	if dns := r.Services.Kubelet.ClusterDNSServer; dns != "" {
+		ip := net.ParseIP(dns)
+		if ip == nil {
+			result.errorf("services.kubelet.clusterDnsServer", "[%s] is not a valid ip address", dns)
+		} else if serviceRange != nil && !serviceRange.Contains(ip) {
+			result.errorf("services.kubelet.clusterDnsServer", "[%s] is not within the service cluster ip range [%s]", dns, serviceRange)
+		}
+	}
+
+	if audit := r.Services.KubeAPI.AuditLog; audit != nil && audit.Enabled {
+		validateAuditLog(audit, result)
+	}
+	if encryption := r.Services.KubeAPI.SecretsEncryptionConfig; encryption != nil && encryption.Enabled {
+		validateSecretsEncryption(encryption, result)
+	}
+}
+
+func validateAuditLog(audit *AuditLog, result *ValidationResult) {
+	const path = "services.kubeApi.auditLog"
+	if audit.Path != "" && !strings.HasPrefix(audit.Path, "/") {
+		result.errorf(path+".path", "[%s] is not an absolute path", audit.Path)
+	}
+	if audit.MaxAge < 0 {
+		result.errorf(path+".maxAge", "must not be negative")
+	}
+	if audit.MaxBackup < 0 {
+		result.errorf(path+".maxBackup", "must not be negative")
+	}
+	if audit.MaxSize < 0 {
+		result.errorf(path+".maxSize", "must not be negative")
+	}
+	if audit.Format != "" && audit.Format != "json" && audit.Format != "legacy" {
+		result.errorf(path+".format", "unsupported format [%s], must be json or legacy", audit.Format)
+	}
+
+	if audit.Policy != "" {
+		policy := struct {
+			Kind  string        `yaml:"kind"`
+			Rules []interface{} `yaml:"rules"`
+		}{}
+		if err := yaml.Unmarshal([]byte(audit.Policy), &policy); err != nil {
+			result.errorf(path+".policy", "invalid policy document: %v", err)
+		} else if policy.Kind != "Policy" {
+			result.errorf(path+".policy", "kind must be Policy, not [%s]", policy.Kind)
+		} else if len(policy.Rules) == 0 {
+			result.warnf(path+".policy", "policy has no rules, nothing will be logged")
+		}
+	}
+}
+
+// encryptionKeySizes are the key sizes in bytes each encryption provider accepts
+var encryptionKeySizes = map[string][]int{
+	"aescbc":    {16, 24, 32},
+	"aesgcm":    {16, 24, 32},
+	"secretbox": {32},
+	"identity":  nil,
+}
+
+func validateSecretsEncryption(encryption *SecretsEncryptionConfig, result *ValidationResult) {
+	const path = "services.kubeApi.secretsEncryptionConfig"
+	if len(encryption.Providers) == 0 {
+		result.errorf(path+".providers", "at least one provider is required")
+		return
+	}
+
+	keyNames := map[string]bool{}
+	for i, provider := range encryption.Providers {
+		providerPath := fmt.Sprintf("%s.providers[%d]", path, i)
+		sizes, ok := encryptionKeySizes[provider.Type]
+		if !ok {
+			result.errorf(providerPath+".type", "unsupported provider [%s], must be one of aescbc, aesgcm, secretbox or identity", provider.Type)
+			continue
+		}
+
+		if provider.Type == "identity" {
+			if len(provider.Keys) > 0 {
+				result.errorf(providerPath+".keys", "the identity provider has no keys")
+			}
+			if i == 0 {
+				result.warnf(providerPath+".type", "new secrets are stored unencrypted when identity is the first provider")
+			}
+			continue
+		}
+
+		if len(provider.Keys) == 0 {
+			result.errorf(providerPath+".keys", "at least one key is required")
+		}
+		for j, key := range provider.Keys {
+			keyPath := fmt.Sprintf("%s.keys[%d]", providerPath, j)
+			if key.Name == "" {
+				result.errorf(keyPath+".name", "name is required")
+			} else if keyNames[key.Name] {
+				result.errorf(keyPath+".name", "key name [%s] is already used", key.Name)
+			}
+			keyNames[key.Name] = true
+
+			secret, err := base64.StdEncoding.DecodeString(key.Secret)
+			if err != nil {
+				result.errorf(keyPath+".secret", "secret is not base64 encoded")
+			} else if !containsInt(sizes, len(secret)) {
+				result.errorf(keyPath+".secret", "%s keys must be %v bytes long, not %d", provider.Type, sizes, len(secret))
+			}
+		}
+	}
+
+	if rotation := encryption.KeyRotation; rotation != nil && rotation.ActiveKey != "" {
+		found := false
+		for _, key := range encryption.Providers[0].Keys {
+			found = found || key.Name == rotation.ActiveKey
+		}
+		if !found {
+			result.errorf(path+".keyRotation.activeKey", "[%s] is not a key of the first provider", rotation.ActiveKey)
+		}
+	}
+}
+
+func (r *RancherKubernetesEngineConfig) validateNetwork(result *ValidationResult) {
+	plugin := r.Network.Plugin
+	if plugin != "" && !contains(RKENetworkPlugins, plugin) {
+		result.errorf("network.plugin", "unsupported plugin [%s], must be one of %v", plugin, RKENetworkPlugins)
+		return
+	}
+
+	if r.Network.CalicoNetworkProvider != nil && plugin != "calico" {
+		result.errorf("network.calicoNetworkProvider", "can only be set when the plugin is calico, not [%s]", plugin)
+	}
+	if r.Network.CanalNetworkProvider != nil && plugin != "canal" && plugin != "" {
+		result.errorf("network.canalNetworkProvider", "can only be set when the plugin is canal, not [%s]", plugin)
+	}
+	if r.Network.FlannelNetworkProvider != nil && plugin != "flannel" {
+		result.errorf("network.flannelNetworkProvider", "can only be set when the plugin is flannel, not [%s]", plugin)
+	}
+}
+
+func (r *RancherKubernetesEngineConfig) validateBastionHost(result *ValidationResult) {
+	bastion := r.BastionHost
+	if bastion.Address == "" {
+		return
+	}
+	if !bastion.SSHAgentAuth && !r.SSHAgentAuth && bastion.SSHKey == "" && bastion.SSHKeySecretRef == nil &&
+		bastion.SSHKeyPath == "" && r.SSHKeyPath == "" {
+		result.errorf("bastionHost.sshKey", "sshKey and sshKeyPath are empty and ssh agent auth is disabled")
+	}
+	validateSecretKeyRef("bastionHost.sshKeySecretRef", bastion.SSHKeySecretRef, result)
+}
+
+func validateSecretKeyRef(field string, ref *SecretKeyRef, result *ValidationResult) {
+	if ref == nil {
+		return
+	}
+	if ref.Namespace == "" {
+		result.errorf(field+".namespace", "namespace is required")
+	}
+	if ref.Name == "" {
+		result.errorf(field+".name", "name is required")
+	}
+	if ref.Key == "" {
+		result.errorf(field+".key", "key is required")
+	}
+}
+
+func (r *RancherKubernetesEngineConfig) validateCloudProvider(result *ValidationResult) {
+	cp := r.CloudProvider
+	if cp.AWSCloudProvider != nil && cp.Name != "aws" {
+		result.errorf("cloudProvider.awsCloudProvider", "can only be set when the cloud provider name is aws, not [%s]", cp.Name)
+	}
+	if cp.AzureCloudProvider != nil && cp.Name != "azure" {
+		result.errorf("cloudProvider.azureCloudProvider", "can only be set when the cloud provider name is azure, not [%s]", cp.Name)
+	}
+	if cp.Name == "azure" && cp.AzureCloudProvider == nil && len(cp.CloudConfig) == 0 {
+		result.errorf("cloudProvider.azureCloudProvider", "required when the cloud provider name is azure")
+	}
+	if cp.VsphereCloudProvider != nil && cp.Name != "vsphere" {
+		result.errorf("cloudProvider.vsphereCloudProvider", "can only be set when the cloud provider name is vsphere, not [%s]", cp.Name)
+	}
+	if cp.Name == "vsphere" && cp.VsphereCloudProvider == nil && len(cp.CloudConfig) == 0 {
+		result.errorf("cloudProvider.vsphereCloudProvider", "required when the cloud provider name is vsphere")
+	}
+	if cp.OpenstackCloudProvider != nil && cp.Name != "openstack" {
+		result.errorf("cloudProvider.openstackCloudProvider", "can only be set when the cloud provider name is openstack, not [%s]", cp.Name)
+	}
+	if cp.Name == "openstack" && cp.OpenstackCloudProvider == nil && len(cp.CloudConfig) == 0 {
+		result.errorf("cloudProvider.openstackCloudProvider", "required when the cloud provider name is openstack")
+	}
+	if len(cp.CloudConfig) > 0 && (cp.AWSCloudProvider != nil || cp.AzureCloudProvider != nil ||
+		cp.VsphereCloudProvider != nil || cp.OpenstackCloudProvider != nil) {
+		result.warnf("cloudProvider.cloudConfig", "ignored when the typed configuration of the provider is set")
+	}
+
+	if aws := cp.AWSCloudProvider; aws != nil {
+		validateAWSCloudProvider(aws, result)
+	}
+	if azure := cp.AzureCloudProvider; azure != nil {
+		validateSecretKeyRef("cloudProvider.azureCloudProvider.aadClientSecretRef", azure.AADClientSecretRef, result)
+		validateSecretKeyRef("cloudProvider.azureCloudProvider.aadClientCertPasswordRef", azure.AADClientCertPasswordRef, result)
+	}
+	if vsphere := cp.VsphereCloudProvider; vsphere != nil {
+		validateVsphereCloudProvider(vsphere, result)
+	}
+	if openstack := cp.OpenstackCloudProvider; openstack != nil {
+		validateOpenstackCloudProvider(openstack, result)
+	}
+}
+
+func validateAWSCloudProvider(aws *AWSCloudProvider, result *ValidationResult) {
+	const path = "cloudProvider.awsCloudProvider"
+	if aws.Global.VPC != "" && aws.Global.SubnetID == "" {
+		result.errorf(path+".global.subnetId", "required when vpc is set")
+	}
+	for _, name := range sortedKeys(aws.ServiceOverride) {
+		override := aws.ServiceOverride[name]
+		overridePath := fmt.Sprintf("%s.serviceOverride[%s]", path, name)
+		if override.Service == "" {
+			result.errorf(overridePath+".service", "service is required")
+		}
+		if override.Region == "" {
+			result.errorf(overridePath+".region", "region is required")
+		}
+		if override.URL == "" {
+			result.errorf(overridePath+".url", "url is required")
+		} else if u, err := url.Parse(override.URL); err != nil || u.Scheme == "" || u.Host == "" {
+			result.errorf(overridePath+".url", "[%s] is not a valid url", override.URL)
+		}
+	}
+}
+
+func validateVsphereCloudProvider(vsphere *VsphereCloudProvider, result *ValidationResult) {
+	const path = "cloudProvider.vsphereCloudProvider"
+	if len(vsphere.VirtualCenter) == 0 && vsphere.Global.VCenterIP == "" {
+		result.errorf(path+".virtualCenter", "at least one virtual center is required")
+	}
+	if vsphere.Global.VCenterIP != "" {
+		result.warnf(path+".global.server", "deprecated, use virtualCenter instead")
+	}
+	for _, server := range sortedKeys(vsphere.VirtualCenter) {
+		vc := vsphere.VirtualCenter[server]
+		vcPath := fmt.Sprintf("%s.virtualCenter[%s]", path, server)
+		if vc.User == "" && vsphere.Global.User == "" {
+			result.errorf(vcPath+".user", "required when global.user is not set")
+		}
+		if vc.Password == "" && vc.PasswordSecretRef == nil && vsphere.Global.Password == "" && vsphere.Global.PasswordSecretRef == nil {
+			result.errorf(vcPath+".password", "required when global.password is not set")
+		}
+		validateSecretKeyRef(vcPath+".passwordSecretRef", vc.PasswordSecretRef, result)
+		if vc.Datacenters == "" && vsphere.Global.Datacenters == "" {
+			result.errorf(vcPath+".datacenters", "required when global.datacenters is not set")
+		}
+		validatePort(vcPath+".port", vc.VCenterPort, result)
+	}
+	validatePort(path+".global.port", vsphere.Global.VCenterPort, result)
+	validateSecretKeyRef(path+".global.passwordSecretRef", vsphere.Global.PasswordSecretRef, result)
+
+	workspace := vsphere.Workspace
+	if workspace.VCenterIP == "" {
+		result.errorf(path+".workspace.server", "server is required")
+	} else if _, ok := vsphere.VirtualCenter[workspace.VCenterIP]; !ok && workspace.VCenterIP != vsphere.Global.VCenterIP {
+		result.errorf(path+".workspace.server", "[%s] is not one of the virtual centers", workspace.VCenterIP)
+	}
+	if workspace.Datacenter == "" {
+		result.errorf(path+".workspace.datacenter", "datacenter is required")
+	}
+	if workspace.Folder == "" {
+		result.errorf(path+".workspace.folder", "folder is required")
+	}
+}
+
+func validateOpenstackCloudProvider(openstack *OpenstackCloudProvider, result *ValidationResult) {
+	const path = "cloudProvider.openstackCloudProvider"
+	global := openstack.Global
+	if global.AuthURL == "" {
+		result.errorf(path+".global.authUrl", "auth url is required")
+	} else if u, err := url.Parse(global.AuthURL); err != nil || u.Scheme == "" || u.Host == "" {
+		result.errorf(path+".global.authUrl", "[%s] is not a valid url", global.AuthURL)
+	}
+	if global.Username == "" && global.UserID == "" {
+		result.errorf(path+".global.username", "either username or userId is required")
+	}
+	if global.Password == "" && global.PasswordSecretRef == nil && global.TrustID == "" {
+		result.errorf(path+".global.password", "either password or trustId is required")
+	}
+	validateSecretKeyRef(path+".global.passwordSecretRef", global.PasswordSecretRef, result)
+	if global.TenantID == "" && global.TenantName == "" && global.TrustID == "" {
+		result.errorf(path+".global.tenantId", "either tenantId or tenantName is required")
+	}
+
+	lb := openstack.LoadBalancer
+	if lb.LBVersion != "" && lb.LBVersion != "v2" {
+		result.errorf(path+".loadBalancer.lbVersion", "unsupported version [%s], only v2 is supported", lb.LBVersion)
+	}
+	if lb.CreateMonitor {
+		if lb.MonitorDelay == "" {
+			result.errorf(path+".loadBalancer.monitorDelay", "required when createMonitor is set")
+		}
+		if lb.MonitorTimeout == "" {
+			result.errorf(path+".loadBalancer.monitorTimeout", "required when createMonitor is set")
+		}
+		if lb.MonitorMaxRetries <= 0 {
+			result.errorf(path+".loadBalancer.monitorMaxRetries", "must be positive when createMonitor is set")
+		}
+	}
+	validateDuration(path+".loadBalancer.monitorDelay", lb.MonitorDelay, result)
+	validateDuration(path+".loadBalancer.monitorTimeout", lb.MonitorTimeout, result)
+	validateDuration(path+".metadata.requestTimeout", openstack.Metadata.RequestTimeout, result)
+
+	switch openstack.BlockStorage.BSVersion {
+	case "", "v1", "v2", "v3", "auto":
+	default:
+		result.errorf(path+".blockStorage.bsVersion", "unsupported version [%s], must be one of v1, v2, v3 or auto", openstack.BlockStorage.BSVersion)
+	}
+	for _, source := range strings.Split(openstack.Metadata.SearchOrder, ",") {
+		switch strings.TrimSpace(source) {
+		case "", "configDrive", "metadataService":
+		default:
+			result.errorf(path+".metadata.searchOrder", "unknown source [%s], must be configDrive or metadataService", source)
+		}
+	}
+}
+
+func validatePort(field, port string, result *ValidationResult) {
+	if port == "" {
+		return
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		result.errorf(field, "[%s] is not a valid port", port)
+	}
+}
+
+func validateDuration(field, duration string, result *ValidationResult) {
+	if duration == "" {
+		return
+	}
+	if _, err := time.ParseDuration(duration); err != nil {
+		result.errorf(field, "[%s] is not a valid duration", duration)
+	}
+}
+
+func (r *RancherKubernetesEngineConfig) validateUpgradeStrategy(result *ValidationResult) {
+	strategy := r.UpgradeStrategy
+	if strategy == nil {
+		return
+	}
+
+	if _, err := strategy.MaxUnavailableWorkers(0); err != nil {
+		result.errorf("upgradeStrategy.maxUnavailable", "%v", err)
+	}
+
+	seen := map[string]bool{}
+	for _, role := range strategy.RoleOrder {
+		if role != ETCDRole && role != ControlPlaneRole && role != WorkerRole {
+			result.errorf("upgradeStrategy.roleOrder", "unknown role [%s]", role)
+		} else if seen[role] {
+			result.errorf("upgradeStrategy.roleOrder", "role [%s] is listed more than once", role)
+		}
+		seen[role] = true
+	}
+	if len(strategy.RoleOrder) > 0 && len(seen) != len(DefaultUpgradeRoleOrder) {
+		result.errorf("upgradeStrategy.roleOrder", "must list each of %v", DefaultUpgradeRoleOrder)
+	}
+
+	if drain := strategy.DrainInput; drain != nil {
+		if !strategy.Drain {
+			result.warnf("upgradeStrategy.nodeDrainInput", "ignored because drain is disabled")
+		}
+		if drain.GracePeriod < -1 {
+			result.errorf("upgradeStrategy.nodeDrainInput.gracePeriod", "must be -1 or greater")
+		}
+		if drain.Timeout < 0 {
+			result.errorf("upgradeStrategy.nodeDrainInput.timeout", "must not be negative")
+		}
+	}
+}
+
+func (r *RancherKubernetesEngineConfig) validateDNS(result *ValidationResult) {
+	dns := r.DNS
+	if dns == nil {
+		return
+	}
+
+	switch dns.Provider {
+	case "", "kube-dns":
+		// kube-dns only takes up to three upstream nameservers in its config map
+		if len(dns.UpstreamNameservers) > 3 {
+			result.errorf("dns.upstreamnameservers", "kube-dns supports at most 3 upstream nameservers, not %d", len(dns.UpstreamNameservers))
+		}
+	case "coredns":
+	case "none":
+		if len(dns.UpstreamNameservers) > 0 || len(dns.StubDomains) > 0 || len(dns.NodeSelector) > 0 {
+			result.warnf("dns", "upstreamnameservers, stubdomains and nodeSelector are ignored when the provider is none")
+		}
+		return
+	default:
+		result.errorf("dns.provider", "unsupported provider [%s], must be one of %v", dns.Provider, RKEDNSProviders)
+		return
+	}
+
+	for i, nameserver := range dns.UpstreamNameservers {
+		if !validNameserver(nameserver) {
+			result.errorf(fmt.Sprintf("dns.upstreamnameservers[%d]", i), "[%s] is not a valid ip address with an optional port", nameserver)
+		}
+	}
+	for _, domain := range sortedKeys(dns.StubDomains) {
+		path := fmt.Sprintf("dns.stubdomains[%s]", domain)
+		if domain == "" || strings.ContainsAny(domain, " /:") {
+			result.errorf(path, "[%s] is not a valid domain", domain)
+		}
+		if len(dns.StubDomains[domain]) == 0 {
+			result.errorf(path, "at least one nameserver is required")
+		}
+		for _, nameserver := range dns.StubDomains[domain] {
+			if !validNameserver(nameserver) {
+				result.errorf(path, "[%s] is not a valid ip address with an optional port", nameserver)
+			}
+		}
+	}
+}
+
+// validNameserver accepts an ip address with an optional port
+func validNameserver(nameserver string) bool {
+	if net.ParseIP(nameserver) != nil {
+		return true
+	}
+	host, port, err := net.SplitHostPort(nameserver)
+	if err != nil || net.ParseIP(host) == nil {
+		return false
+	}
+	n, err := strconv.Atoi(port)
+	return err == nil && n > 0 && n <= 65535
+}
+
+func parseCIDR(result *ValidationResult, field, cidr string) *net.IPNet {
+	if cidr == "" {
+		return nil
+	}
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		result.errorf(field, "[%s] is not a valid CIDR", cidr)
+		return nil
+	}
+	return ipNet
+}
+
+func overlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func containsInt(list []int, value int) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedKeys returns the keys of a map with string keys in sorted order
+func sortedKeys(m interface{}) []string {
+	var result []string
+	for _, key := range reflect.ValueOf(m).MapKeys() {
+		result = append(result, key.String())
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Validate checks the S3 target of etcd snapshots. The endpoint is a host with an optional port, or an http
+// or https URL without a path.
+func (s *S3BackupConfig) Validate() ValidationResult {
+	result := ValidationResult{}
+
+	switch {
+	case s.BucketName == "":
+		result.errorf("bucketName", "bucket name is required")
+	case !s3BucketRegexp.MatchString(s.BucketName) || strings.Contains(s.BucketName, "..") || net.ParseIP(s.BucketName) != nil:
+		result.errorf("bucketName", "[%s] is not a valid bucket name, must be 3 to 63 lowercase letters, digits, dots and hyphens", s.BucketName)
+	}
+
+	if s.Endpoint != "" {
+		endpoint := s.Endpoint
+		if !strings.Contains(endpoint, "://") {
+			endpoint = "https://" + endpoint
+		}
+		u, err := url.Parse(endpoint)
+		switch {
+		case err != nil || u.Hostname() == "":
+			result.errorf("endpoint", "[%s] is not a valid endpoint", s.Endpoint)
+		case u.Scheme != "http" && u.Scheme != "https":
+			result.errorf("endpoint", "[%s] must use http or https", s.Endpoint)
+		case strings.Trim(u.Path, "/") != "" || u.RawQuery != "":
+			result.errorf("endpoint", "[%s] must not have a path, set the folder instead", s.Endpoint)
+		case u.Scheme == "http":
+			result.warnf("endpoint", "[%s] is not encrypted, the snapshots and credentials are sent in clear text", s.Endpoint)
+		}
+	}
+
+	if s.Region != "" && !s3RegionRegexp.MatchString(s.Region) {
+		result.errorf("region", "[%s] is not a valid region, must be lowercase letters, digits and hyphens such as us-east-1", s.Region)
+	}
+
+	if strings.HasPrefix(s.Folder, "/") {
+		result.errorf("folder", "[%s] must be relative to the bucket", s.Folder)
+	}
+
+	if secret := s.CredentialSecret; secret == nil || secret.Name == "" {
+		result.warnf("credentialSecret", "no credentials set, the bucket must allow anonymous access")
+	} else {
+		for _, msg := range validation.IsDNS1123Subdomain(secret.Name) {
+			result.errorf("credentialSecret.name", "invalid name [%s]: %s", secret.Name, msg)
+		}
+		if secret.Namespace != "" {
+			for _, msg := range validation.IsDNS1123Label(secret.Namespace) {
+				result.errorf("credentialSecret.namespace", "invalid namespace [%s]: %s", secret.Namespace, msg)
+			}
+		}
+	}
+
+	return result
+}
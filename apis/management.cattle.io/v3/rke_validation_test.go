@@ -0,0 +1,293 @@
+package v3
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(*RancherKubernetesEngineConfig)
+		errors   []string
+		warnings []string
+	}{
+		{
+			name:   "valid",
+			modify: func(r *RancherKubernetesEngineConfig) {},
+		},
+		{
+			name: "no etcd role",
+			modify: func(r *RancherKubernetesEngineConfig) {
+				r.Nodes[0].Role = []string{ControlPlaneRole}
+			},
+			errors: []string{"nodes"},
+		},
+		{
+			name: "external etcd",
+			modify: func(r *RancherKubernetesEngineConfig) {
+				r.Nodes[0].Role = []string{ControlPlaneRole}
+				r.Services.Etcd.ExternalURLs = []string{"https://etcd.example.com:2379"}
+				r.Services.Etcd.CACert = "ca"
+				r.Services.Etcd.Cert = "cert"
+				r.Services.Etcd.Key = "key"
+			},
+		},
+		{
+			name: "even etcd count",
+			modify: func(r *RancherKubernetesEngineConfig) {
+				r.Nodes[1].Role = append(r.Nodes[1].Role, ETCDRole)
+			},
+			warnings: []string{"nodes"},
+		},
+		{
+			name: "cluster cidr overlaps service range",
+			modify: func(r *RancherKubernetesEngineConfig) {
+				r.Services.KubeAPI.ServiceClusterIPRange = "10.43.0.0/16"
+				r.Services.KubeController.ServiceClusterIPRange = "10.43.0.0/16"
+				r.Services.KubeController.ClusterCIDR = "10.0.0.0/8"
+			},
+			errors: []string{"services.kubeController.clusterCidr"},
+		},
+		{
+			name: "separate cluster cidr",
+			modify: func(r *RancherKubernetesEngineConfig) {
+				r.Services.KubeAPI.ServiceClusterIPRange = "10.43.0.0/16"
+				r.Services.KubeController.ServiceClusterIPRange = "10.43.0.0/16"
+				r.Services.KubeController.ClusterCIDR = "10.42.0.0/16"
+			},
+		},
+		{
+			name: "unsupported plugin",
+			modify: func(r *RancherKubernetesEngineConfig) {
+				r.Network.Plugin = "cilium"
+			},
+			errors: []string{"network.plugin"},
+		},
+		{
+			name: "calico options with flannel",
+			modify: func(r *RancherKubernetesEngineConfig) {
+				r.Network.Plugin = "flannel"
+				r.Network.CalicoNetworkProvider = &CalicoNetworkProvider{}
+			},
+			errors: []string{"network.calicoNetworkProvider"},
+		},
+		{
+			name: "flannel options with calico",
+			modify: func(r *RancherKubernetesEngineConfig) {
+				r.Network.Plugin = "calico"
+				r.Network.FlannelNetworkProvider = &FlannelNetworkProvider{}
+			},
+			errors: []string{"network.flannelNetworkProvider"},
+		},
+		{
+			name: "missing ssh key",
+			modify: func(r *RancherKubernetesEngineConfig) {
+				r.Nodes[2].SSHKey = ""
+			},
+			errors: []string{"nodes[2].sshKey"},
+		},
+		{
+			name: "ssh key path",
+			modify: func(r *RancherKubernetesEngineConfig) {
+				r.Nodes[2].SSHKey = ""
+				r.SSHKeyPath = "~/.ssh/id_rsa"
+			},
+		},
+		{
+			name: "ssh agent",
+			modify: func(r *RancherKubernetesEngineConfig) {
+				r.Nodes[2].SSHKey = ""
+				r.Nodes[2].SSHAgentAuth = true
+			},
+		},
+	}
+
+	for _, test := range tests {
+		config := &RancherKubernetesEngineConfig{
+			Nodes: []RKEConfigNode{
+				{Address: "10.0.0.1", Role: []string{ETCDRole}, SSHKey: "key"},
+				{Address: "10.0.0.2", Role: []string{ControlPlaneRole}, SSHKey: "key"},
+				{Address: "10.0.0.3", Role: []string{WorkerRole}, SSHKey: "key"},
+			},
+			Network: NetworkConfig{Plugin: "canal"},
+		}
+		test.modify(config)
+
+		result := config.Validate()
+		if errors := fields(result.Errors); !reflect.DeepEqual(errors, test.errors) {
+			t.Errorf("%s: expected errors %v, got %v", test.name, test.errors, result.Errors)
+		}
+		if warnings := fields(result.Warnings); !reflect.DeepEqual(warnings, test.warnings) {
+			t.Errorf("%s: expected warnings %v, got %v", test.name, test.warnings, result.Warnings)
+		}
+	}
+}
+
+func TestS3BackupConfigValidate(t *testing.T) {
+	credentials := &v1.SecretReference{Namespace: "cattle-system", Name: "s3-creds"}
+
+	tests := []struct {
+		name     string
+		config   S3BackupConfig
+		errors   []string
+		warnings []string
+	}{
+		{
+			name:   "aws",
+			config: S3BackupConfig{BucketName: "etcd-snapshots", Endpoint: "s3.amazonaws.com", Region: "us-east-1", CredentialSecret: credentials},
+		},
+		{
+			name:     "local stand-in",
+			config:   S3BackupConfig{BucketName: "snapshots", Endpoint: "http://127.0.0.1:9000", Folder: "c-1", CredentialSecret: credentials},
+			warnings: []string{"endpoint"},
+		},
+		{
+			name:     "anonymous",
+			config:   S3BackupConfig{BucketName: "snapshots"},
+			warnings: []string{"credentialSecret"},
+		},
+		{
+			name:   "missing bucket",
+			config: S3BackupConfig{CredentialSecret: credentials},
+			errors: []string{"bucketName"},
+		},
+		{
+			name:   "invalid bucket",
+			config: S3BackupConfig{BucketName: "Etcd_Snapshots", CredentialSecret: credentials},
+			errors: []string{"bucketName"},
+		},
+		{
+			name:   "bucket like an ip",
+			config: S3BackupConfig{BucketName: "192.168.1.1", CredentialSecret: credentials},
+			errors: []string{"bucketName"},
+		},
+		{
+			name:   "endpoint with path",
+			config: S3BackupConfig{BucketName: "snapshots", Endpoint: "https://minio.example.com/snapshots", CredentialSecret: credentials},
+			errors: []string{"endpoint"},
+		},
+		{
+			name:   "endpoint scheme",
+			config: S3BackupConfig{BucketName: "snapshots", Endpoint: "ftp://minio.example.com", CredentialSecret: credentials},
+			errors: []string{"endpoint"},
+		},
+		{
+			name:   "region",
+			config: S3BackupConfig{BucketName: "snapshots", Region: "US East", CredentialSecret: credentials},
+			errors: []string{"region"},
+		},
+		{
+			name:   "absolute folder",
+			config: S3BackupConfig{BucketName: "snapshots", Folder: "/c-1", CredentialSecret: credentials},
+			errors: []string{"folder"},
+		},
+		{
+			name:   "credential secret",
+			config: S3BackupConfig{BucketName: "snapshots", CredentialSecret: &v1.SecretReference{Namespace: "Cattle", Name: "S3_creds"}},
+			errors: []string{"credentialSecret.name", "credentialSecret.namespace"},
+		},
+	}
+
+	for _, test := range tests {
+		result := test.config.Validate()
+		if errors := fields(result.Errors); !reflect.DeepEqual(errors, test.errors) {
+			t.Errorf("%s: expected errors %v, got %v", test.name, test.errors, result.Errors)
+		}
+		if warnings := fields(result.Warnings); !reflect.DeepEqual(warnings, test.warnings) {
+			t.Errorf("%s: expected warnings %v, got %v", test.name, test.warnings, result.Warnings)
+		}
+	}
+}
+
+func TestValidateBackupConfigPrefixesS3Fields(t *testing.T) {
+	config := &RancherKubernetesEngineConfig{}
+	config.Services.Etcd.BackupConfig = &BackupConfig{
+		S3BackupConfig: &S3BackupConfig{BucketName: "snapshots", Endpoint: "https://minio.example.com/path"},
+	}
+	result := ValidationResult{}
+	config.validateServices(&result)
+
+	found := false
+	for _, e := range result.Errors {
+		found = found || e.Field == "services.etcd.backupConfig.s3BackupConfig.endpoint"
+	}
+	if !found {
+		t.Errorf("expected an error of services.etcd.backupConfig.s3BackupConfig.endpoint, got %v", result.Errors)
+	}
+}
+
+func fields(errors []FieldError) []string {
+	var result []string
+	for _, e := range errors {
+		result = append(result, e.Field)
+	}
+	return result
+}
+
+func TestValidateDNS(t *testing.T) {
+	tests := []struct {
+		name     string
+		dns      *DNSConfig
+		errors   []string
+		warnings []string
+	}{
+		{
+			name: "not set",
+		},
+		{
+			name: "kube-dns",
+			dns: &DNSConfig{
+				UpstreamNameservers: []string{"1.1.1.1", "8.8.8.8:53", "[2001:db8::1]:53"},
+				StubDomains:         map[string][]string{"corp.example.com": {"10.0.0.2"}},
+			},
+		},
+		{
+			name:   "too many kube-dns nameservers",
+			dns:    &DNSConfig{Provider: "kube-dns", UpstreamNameservers: []string{"1.1.1.1", "1.0.0.1", "8.8.8.8", "8.8.4.4"}},
+			errors: []string{"dns.upstreamnameservers"},
+		},
+		{
+			name: "coredns takes more nameservers",
+			dns:  &DNSConfig{Provider: "coredns", UpstreamNameservers: []string{"1.1.1.1", "1.0.0.1", "8.8.8.8", "8.8.4.4"}},
+		},
+		{
+			name:   "invalid nameservers",
+			dns:    &DNSConfig{Provider: "coredns", UpstreamNameservers: []string{"dns.example.com", "10.0.0.1:port"}},
+			errors: []string{"dns.upstreamnameservers[0]", "dns.upstreamnameservers[1]"},
+		},
+		{
+			name: "invalid stub domains",
+			dns: &DNSConfig{StubDomains: map[string][]string{
+				"corp.example.com": nil,
+				"lab example.com":  {"10.0.0.3"},
+				"dev.example.com":  {"ns.example.com"},
+			}},
+			errors: []string{"dns.stubdomains[corp.example.com]", "dns.stubdomains[dev.example.com]", "dns.stubdomains[lab example.com]"},
+		},
+		{
+			name:     "options without a provider",
+			dns:      &DNSConfig{Provider: "none", UpstreamNameservers: []string{"dns.example.com"}},
+			warnings: []string{"dns"},
+		},
+		{
+			name:   "unsupported provider",
+			dns:    &DNSConfig{Provider: "bind"},
+			errors: []string{"dns.provider"},
+		},
+	}
+
+	for _, test := range tests {
+		config := &RancherKubernetesEngineConfig{DNS: test.dns}
+		result := ValidationResult{}
+		config.validateDNS(&result)
+		if errors := fields(result.Errors); !reflect.DeepEqual(errors, test.errors) {
+			t.Errorf("%s: expected errors %v, got %v", test.name, test.errors, result.Errors)
+		}
+		if warnings := fields(result.Warnings); !reflect.DeepEqual(warnings, test.warnings) {
+			t.Errorf("%s: expected warnings %v, got %v", test.name, test.warnings, result.Warnings)
+		}
+	}
+}
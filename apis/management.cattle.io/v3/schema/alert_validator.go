@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"github.com/rancher/types/notify"
+)
+
+// alertValidator rejects cluster and project alerts with inhibit rules or silence windows alertmanager
+// would not accept, a message template that does not render, or a metric rule prometheus would not load
+func alertValidator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	common := &v3.AlertCommonSpec{}
+	if err := convert.ToObj(data, common); err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, "invalid alert")
+	}
+	if result := common.Validate(); len(result.Errors) > 0 {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, result.Errors[0].Field, result.Err().Error())
+	}
+	if err := validateMessageTemplate(common.MessageTemplate); err != nil {
+		return err
+	}
+
+	value, ok := data["targetMetric"]
+	if !ok || value == nil {
+		return nil
+	}
+
+	rule := &v3.MetricRule{}
+	if err := convert.ToObj(value, rule); err != nil {
+		return httperror.WrapFieldAPIError(err, httperror.InvalidBodyContent, "targetMetric", "invalid targetMetric")
+	}
+	if result := rule.Validate(); len(result.Errors) > 0 {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, "targetMetric."+result.Errors[0].Field, result.Err().Error())
+	}
+	return nil
+}
+
+// alertGroupValidator rejects cluster and project alert groups that group by invalid labels
+func alertGroupValidator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	group := &v3.AlertGroupSpec{}
+	if err := convert.ToObj(data, group); err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, "invalid alert group")
+	}
+	if result := group.Validate(); len(result.Errors) > 0 {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, result.Errors[0].Field, result.Err().Error())
+	}
+	return nil
+}
+
+// notifierValidator rejects notifiers without exactly one config, with a config that can not be sent to, or
+// with a message template that does not render
+func notifierValidator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	spec := &v3.NotifierSpec{}
+	if err := convert.ToObj(data, spec); err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, "invalid notifier")
+	}
+	if result := spec.Validate(); len(result.Errors) > 0 {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, result.Errors[0].Field, result.Err().Error())
+	}
+	return validateMessageTemplate(spec.MessageTemplate)
+}
+
+func validateMessageTemplate(text string) error {
+	if text == "" {
+		return nil
+	}
+	if err := notify.ValidateTemplate(text); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, "messageTemplate", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+type clusterConfig interface {
+	Validate() v3.ValidationResult
+}
+
+// clusterConfigs are the fields of the cluster spec that are validated, along with a constructor of the
+// config each of them holds
+var clusterConfigs = []struct {
+	field  string
+	config func() clusterConfig
+}{
+	{"rancherKubernetesEngineConfig", func() clusterConfig { return &v3.RancherKubernetesEngineConfig{} }},
+	{"amazonElasticContainerServiceConfig", func() clusterConfig { return &v3.AmazonElasticContainerServiceConfig{} }},
+	{"googleKubernetesEngineConfig", func() clusterConfig { return &v3.GoogleKubernetesEngineConfig{} }},
+	{"azureKubernetesServiceConfig", func() clusterConfig { return &v3.AzureKubernetesServiceConfig{} }},
+	{"monitoringConfig", func() clusterConfig { return &v3.MonitoringConfig{} }},
+}
+
+// clusterValidator rejects clusters whose RKE, hosted provider or monitoring config would fail to deploy, warnings
+// are not reported
+func clusterValidator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	for _, c := range clusterConfigs {
+		value, ok := data[c.field]
+		if !ok || value == nil {
+			continue
+		}
+
+		config := c.config()
+		if err := convert.ToObj(value, config); err != nil {
+			return httperror.WrapFieldAPIError(err, httperror.InvalidBodyContent, c.field, "invalid "+c.field)
+		}
+
+		result := config.Validate()
+		if len(result.Errors) > 0 {
+			return httperror.NewFieldAPIError(httperror.InvalidOption, c.field+"."+result.Errors[0].Field, result.Err().Error())
+		}
+	}
+	return nil
+}
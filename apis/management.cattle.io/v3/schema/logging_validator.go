@@ -0,0 +1,23 @@
+package schema
+
+import (
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+// loggingValidator rejects cluster and project loggings whose filter has invalid selectors or patterns
+func loggingValidator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	spec := &v3.LoggingCommonSpec{}
+	if err := convert.ToObj(data, spec); err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, "invalid logging")
+	}
+	if spec.Filter == nil {
+		return nil
+	}
+	if result := spec.Filter.Validate(); len(result.Errors) > 0 {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, "filter."+result.Errors[0].Field, result.Err().Error())
+	}
+	return nil
+}
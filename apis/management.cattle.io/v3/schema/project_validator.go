@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"github.com/rancher/norman/httperror"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"github.com/rancher/types/resourcequota"
+)
+
+// projectValidator rejects projects whose namespace default quota does not fit in the project quota or whose
+// monitoring config would fail to deploy
+func projectValidator(request *types.APIContext, schema *types.Schema, data map[string]interface{}) error {
+	spec := &v3.ProjectSpec{}
+	if err := convert.ToObj(data, spec); err != nil {
+		return httperror.WrapAPIError(err, httperror.InvalidBodyContent, "invalid project")
+	}
+
+	if err := resourcequota.ValidateProject(spec); err != nil {
+		return httperror.NewFieldAPIError(httperror.InvalidOption, "resourceQuota", err.Error())
+	}
+	if spec.MonitoringConfig != nil {
+		if result := spec.MonitoringConfig.Validate(); len(result.Errors) > 0 {
+			return httperror.NewFieldAPIError(httperror.InvalidOption, "monitoringConfig."+result.Errors[0].Field, result.Err().Error())
+		}
+	}
+	return nil
+}
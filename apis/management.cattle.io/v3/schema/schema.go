@@ -24,6 +24,8 @@ var (
 		Init(nodeTypes).
 		Init(authzTypes).
 		Init(clusterTypes).
+		Init(backupTypes).
+		Init(clusterTemplateTypes).
 		Init(catalogTypes).
 		Init(authnTypes).
 		Init(tokens).
@@ -34,6 +36,7 @@ var (
 		Init(globalTypes).
 		Init(rkeTypes).
 		Init(alertTypes).
+		Init(monitoringTypes).
 		Init(pipelineTypes).
 		Init(composeType)
 
@@ -42,7 +45,10 @@ var (
 )
 
 func rkeTypes(schemas *types.Schemas) *types.Schemas {
-	return schemas.AddMapperForType(&Version, v3.BaseService{}, m.Drop{Field: "image"})
+	return schemas.AddMapperForType(&Version, v3.BaseService{}, m.Drop{Field: "image"}).
+		MustImport(&Version, v3.AWSCloudProvider{}).
+		MustImport(&Version, v3.VsphereCloudProvider{}).
+		MustImport(&Version, v3.OpenstackCloudProvider{})
 }
 
 func schemaTypes(schemas *types.Schemas) *types.Schemas {
@@ -126,6 +132,7 @@ func clusterTypes(schemas *types.Schemas) *types.Schemas {
 		).
 		AddMapperForType(&Version, v3.ClusterStatus{},
 			m.Drop{Field: "serviceAccountToken"},
+			&mapper.SecretFields{Fields: []string{"appliedSpec", "failedSpec"}},
 		).
 		AddMapperForType(&Version, v3.ClusterEvent{}, &m.Move{
 			From: "type",
@@ -143,7 +150,9 @@ func clusterTypes(schemas *types.Schemas) *types.Schemas {
 		MustImport(&Version, v3.GenerateKubeConfigOutput{}).
 		MustImport(&Version, v3.ImportClusterYamlInput{}).
 		MustImport(&Version, v3.ImportYamlOutput{}).
+		MustImport(&Version, v3.RestoreFromEtcdBackupInput{}).
 		MustImportAndCustomize(&Version, v3.Cluster{}, func(schema *types.Schema) {
+			schema.Validator = clusterValidator
 			schema.MustCustomizeField("name", func(field types.Field) types.Field {
 				field.Type = "dnsLabel"
 				field.Nullable = true
@@ -157,9 +166,34 @@ func clusterTypes(schemas *types.Schemas) *types.Schemas {
 				Input:  "importClusterYamlInput",
 				Output: "importYamlOutput",
 			}
+			schema.ResourceActions["backupEtcd"] = types.Action{
+				Output: "clusterBackup",
+			}
+			schema.ResourceActions["restoreFromEtcdBackup"] = types.Action{
+				Input: "restoreFromEtcdBackupInput",
+			}
+			schema.ResourceActions["rollback"] = types.Action{
+				Output: "cluster",
+			}
 		})
 }
 
+func backupTypes(schemas *types.Schemas) *types.Schemas {
+	return schemas.
+		AddMapperForType(&Version, v3.ClusterBackup{},
+			&m.Embed{Field: "status"},
+		).
+		MustImport(&Version, v3.ClusterBackup{})
+}
+
+func clusterTemplateTypes(schemas *types.Schemas) *types.Schemas {
+	return schemas.
+		AddMapperForType(&Version, v3.ClusterTemplate{}, m.DisplayName{}).
+		AddMapperForType(&Version, v3.ClusterTemplateRevision{}, m.DisplayName{}).
+		MustImport(&Version, v3.ClusterTemplate{}).
+		MustImport(&Version, v3.ClusterTemplateRevision{})
+}
+
 func authzTypes(schemas *types.Schemas) *types.Schemas {
 	return schemas.
 		MustImport(&Version, v3.ProjectStatus{}).
@@ -176,6 +210,7 @@ func authzTypes(schemas *types.Schemas) *types.Schemas {
 		MustImport(&Version, v3.SetPodSecurityPolicyTemplateInput{}).
 		MustImport(&Version, v3.ImportYamlOutput{}).
 		MustImportAndCustomize(&Version, v3.Project{}, func(schema *types.Schema) {
+			schema.Validator = projectValidator
 			schema.ResourceActions = map[string]types.Action{
 				"setpodsecuritypolicytemplate": {
 					Input:  "setPodSecurityPolicyTemplateInput",
@@ -214,6 +249,7 @@ func nodeTypes(schemas *types.Schemas) *types.Schemas {
 			&m.Move{From: "nodeAnnotations", To: "annotations"},
 			&m.Drop{Field: "desiredNodeLabels"},
 			&m.Drop{Field: "desiredNodeAnnotations"},
+			&m.Drop{Field: "desiredNodeTaints"},
 			&m.AnnotationField{Field: "publicEndpoints", List: true},
 			m.Copy{From: "namespaceId", To: "clusterName"},
 			m.DisplayName{}).
@@ -372,8 +408,12 @@ func logTypes(schema *types.Schemas) *types.Schemas {
 			m.DisplayName{}).
 		AddMapperForType(&Version, v3.ProjectLogging{},
 			m.DisplayName{}).
-		MustImport(&Version, v3.ClusterLogging{}).
-		MustImport(&Version, v3.ProjectLogging{})
+		MustImportAndCustomize(&Version, v3.ClusterLogging{}, func(schema *types.Schema) {
+			schema.Validator = loggingValidator
+		}).
+		MustImportAndCustomize(&Version, v3.ProjectLogging{}, func(schema *types.Schema) {
+			schema.Validator = loggingValidator
+		})
 }
 
 func globalTypes(schema *types.Schemas) *types.Schemas {
@@ -402,21 +442,36 @@ func alertTypes(schema *types.Schemas) *types.Schemas {
 		AddMapperForType(&Version, v3.ProjectAlert{},
 			&m.Embed{Field: "status"},
 			m.DisplayName{}).
+		AddMapperForType(&Version, v3.ClusterAlertGroup{},
+			m.DisplayName{}).
+		AddMapperForType(&Version, v3.ProjectAlertGroup{},
+			m.DisplayName{}).
 		MustImport(&Version, v3.Notification{}).
+		MustImport(&Version, v3.MessageTemplatePreviewInput{}).
+		MustImport(&Version, v3.MessageTemplatePreviewOutput{}).
 		MustImportAndCustomize(&Version, v3.Notifier{}, func(schema *types.Schema) {
+			schema.Validator = notifierValidator
 			schema.CollectionActions = map[string]types.Action{
 				"send": {
 					Input: "notification",
 				},
+				"preview": {
+					Input:  "messageTemplatePreviewInput",
+					Output: "messageTemplatePreviewOutput",
+				},
 			}
 			schema.ResourceActions = map[string]types.Action{
 				"send": {
 					Input: "notification",
 				},
+				"preview": {
+					Input:  "messageTemplatePreviewInput",
+					Output: "messageTemplatePreviewOutput",
+				},
 			}
 		}).
 		MustImportAndCustomize(&Version, v3.ClusterAlert{}, func(schema *types.Schema) {
-
+			schema.Validator = alertValidator
 			schema.ResourceActions = map[string]types.Action{
 				"activate":   {},
 				"deactivate": {},
@@ -425,17 +480,28 @@ func alertTypes(schema *types.Schemas) *types.Schemas {
 			}
 		}).
 		MustImportAndCustomize(&Version, v3.ProjectAlert{}, func(schema *types.Schema) {
-
+			schema.Validator = alertValidator
 			schema.ResourceActions = map[string]types.Action{
 				"activate":   {},
 				"deactivate": {},
 				"mute":       {},
 				"unmute":     {},
 			}
+		}).
+		MustImportAndCustomize(&Version, v3.ClusterAlertGroup{}, func(schema *types.Schema) {
+			schema.Validator = alertGroupValidator
+		}).
+		MustImportAndCustomize(&Version, v3.ProjectAlertGroup{}, func(schema *types.Schema) {
+			schema.Validator = alertGroupValidator
 		})
 
 }
 
+func monitoringTypes(schema *types.Schemas) *types.Schemas {
+	return schema.
+		MustImport(&Version, v3.MonitorMetric{})
+}
+
 func pipelineTypes(schema *types.Schemas) *types.Schemas {
 	return schema.
 		AddMapperForType(&Version, v3.ClusterPipeline{}).
@@ -0,0 +1,252 @@
+package v3
+
+import (
+	"context"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	ClusterBackupGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "ClusterBackup",
+	}
+	ClusterBackupResource = metav1.APIResource{
+		Name:         "clusterbackups",
+		SingularName: "clusterbackup",
+		Namespaced:   true,
+
+		Kind: ClusterBackupGroupVersionKind.Kind,
+	}
+)
+
+type ClusterBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterBackup
+}
+
+type ClusterBackupHandlerFunc func(key string, obj *ClusterBackup) error
+
+type ClusterBackupLister interface {
+	List(namespace string, selector labels.Selector) (ret []*ClusterBackup, err error)
+	Get(namespace, name string) (*ClusterBackup, error)
+}
+
+type ClusterBackupController interface {
+	Informer() cache.SharedIndexInformer
+	Lister() ClusterBackupLister
+	AddHandler(name string, handler ClusterBackupHandlerFunc)
+	AddClusterScopedHandler(name, clusterName string, handler ClusterBackupHandlerFunc)
+	Enqueue(namespace, name string)
+	Sync(ctx context.Context) error
+	Start(ctx context.Context, threadiness int) error
+}
+
+type ClusterBackupInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*ClusterBackup) (*ClusterBackup, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*ClusterBackup, error)
+	Get(name string, opts metav1.GetOptions) (*ClusterBackup, error)
+	Update(*ClusterBackup) (*ClusterBackup, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*ClusterBackupList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() ClusterBackupController
+	AddHandler(name string, sync ClusterBackupHandlerFunc)
+	AddLifecycle(name string, lifecycle ClusterBackupLifecycle)
+	AddClusterScopedHandler(name, clusterName string, sync ClusterBackupHandlerFunc)
+	AddClusterScopedLifecycle(name, clusterName string, lifecycle ClusterBackupLifecycle)
+}
+
+type clusterBackupLister struct {
+	controller *clusterBackupController
+}
+
+func (l *clusterBackupLister) List(namespace string, selector labels.Selector) (ret []*ClusterBackup, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*ClusterBackup))
+	})
+	return
+}
+
+func (l *clusterBackupLister) Get(namespace, name string) (*ClusterBackup, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ClusterBackupGroupVersionKind.Group,
+			Resource: "clusterBackup",
+		}, name)
+	}
+	return obj.(*ClusterBackup), nil
+}
+
+type clusterBackupController struct {
+	controller.GenericController
+}
+
+func (c *clusterBackupController) Lister() ClusterBackupLister {
+	return &clusterBackupLister{
+		controller: c,
+	}
+}
+
+func (c *clusterBackupController) AddHandler(name string, handler ClusterBackupHandlerFunc) {
+	c.GenericController.AddHandler(name, func(key string) error {
+		obj, exists, err := c.Informer().GetStore().GetByKey(key)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return handler(key, nil)
+		}
+		return handler(key, obj.(*ClusterBackup))
+	})
+}
+
+func (c *clusterBackupController) AddClusterScopedHandler(name, cluster string, handler ClusterBackupHandlerFunc) {
+	c.GenericController.AddHandler(name, func(key string) error {
+		obj, exists, err := c.Informer().GetStore().GetByKey(key)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return handler(key, nil)
+		}
+
+		if !controller.ObjectInCluster(cluster, obj) {
+			return nil
+		}
+
+		return handler(key, obj.(*ClusterBackup))
+	})
+}
+
+type clusterBackupFactory struct {
+}
+
+func (c clusterBackupFactory) Object() runtime.Object {
+	return &ClusterBackup{}
+}
+
+func (c clusterBackupFactory) List() runtime.Object {
+	return &ClusterBackupList{}
+}
+
+func (s *clusterBackupClient) Controller() ClusterBackupController {
+	s.client.Lock()
+	defer s.client.Unlock()
+
+	c, ok := s.client.clusterBackupControllers[s.ns]
+	if ok {
+		return c
+	}
+
+	genericController := controller.NewGenericController(ClusterBackupGroupVersionKind.Kind+"Controller",
+		s.objectClient)
+
+	c = &clusterBackupController{
+		GenericController: genericController,
+	}
+
+	s.client.clusterBackupControllers[s.ns] = c
+	s.client.starters = append(s.client.starters, c)
+
+	return c
+}
+
+type clusterBackupClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   ClusterBackupController
+}
+
+func (s *clusterBackupClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *clusterBackupClient) Create(o *ClusterBackup) (*ClusterBackup, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*ClusterBackup), err
+}
+
+func (s *clusterBackupClient) Get(name string, opts metav1.GetOptions) (*ClusterBackup, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*ClusterBackup), err
+}
+
+func (s *clusterBackupClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*ClusterBackup, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*ClusterBackup), err
+}
+
+func (s *clusterBackupClient) Update(o *ClusterBackup) (*ClusterBackup, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*ClusterBackup), err
+}
+
+func (s *clusterBackupClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *clusterBackupClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterBackupClient) List(opts metav1.ListOptions) (*ClusterBackupList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*ClusterBackupList), err
+}
+
+func (s *clusterBackupClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *clusterBackupClient) Patch(o *ClusterBackup, data []byte, subresources ...string) (*ClusterBackup, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, data, subresources...)
+	return obj.(*ClusterBackup), err
+}
+
+func (s *clusterBackupClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterBackupClient) AddHandler(name string, sync ClusterBackupHandlerFunc) {
+	s.Controller().AddHandler(name, sync)
+}
+
+func (s *clusterBackupClient) AddLifecycle(name string, lifecycle ClusterBackupLifecycle) {
+	sync := NewClusterBackupLifecycleAdapter(name, false, s, lifecycle)
+	s.AddHandler(name, sync)
+}
+
+func (s *clusterBackupClient) AddClusterScopedHandler(name, clusterName string, sync ClusterBackupHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(name, clusterName, sync)
+}
+
+func (s *clusterBackupClient) AddClusterScopedLifecycle(name, clusterName string, lifecycle ClusterBackupLifecycle) {
+	sync := NewClusterBackupLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.AddClusterScopedHandler(name, clusterName, sync)
+}
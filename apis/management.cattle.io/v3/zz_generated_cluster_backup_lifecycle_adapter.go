@@ -0,0 +1,51 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ClusterBackupLifecycle interface {
+	Create(obj *ClusterBackup) (*ClusterBackup, error)
+	Remove(obj *ClusterBackup) (*ClusterBackup, error)
+	Updated(obj *ClusterBackup) (*ClusterBackup, error)
+}
+
+type clusterBackupLifecycleAdapter struct {
+	lifecycle ClusterBackupLifecycle
+}
+
+func (w *clusterBackupLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*ClusterBackup))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterBackupLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*ClusterBackup))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterBackupLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*ClusterBackup))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewClusterBackupLifecycleAdapter(name string, clusterScoped bool, client ClusterBackupInterface, l ClusterBackupLifecycle) ClusterBackupHandlerFunc {
+	adapter := &clusterBackupLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *ClusterBackup) error {
+		if obj == nil {
+			return syncFn(key, nil)
+		}
+		return syncFn(key, obj)
+	}
+}
@@ -0,0 +1,251 @@
+package v3
+
+import (
+	"context"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	ClusterTemplateGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "ClusterTemplate",
+	}
+	ClusterTemplateResource = metav1.APIResource{
+		Name:         "clustertemplates",
+		SingularName: "clustertemplate",
+		Namespaced:   false,
+		Kind:         ClusterTemplateGroupVersionKind.Kind,
+	}
+)
+
+type ClusterTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterTemplate
+}
+
+type ClusterTemplateHandlerFunc func(key string, obj *ClusterTemplate) error
+
+type ClusterTemplateLister interface {
+	List(namespace string, selector labels.Selector) (ret []*ClusterTemplate, err error)
+	Get(namespace, name string) (*ClusterTemplate, error)
+}
+
+type ClusterTemplateController interface {
+	Informer() cache.SharedIndexInformer
+	Lister() ClusterTemplateLister
+	AddHandler(name string, handler ClusterTemplateHandlerFunc)
+	AddClusterScopedHandler(name, clusterName string, handler ClusterTemplateHandlerFunc)
+	Enqueue(namespace, name string)
+	Sync(ctx context.Context) error
+	Start(ctx context.Context, threadiness int) error
+}
+
+type ClusterTemplateInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*ClusterTemplate) (*ClusterTemplate, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*ClusterTemplate, error)
+	Get(name string, opts metav1.GetOptions) (*ClusterTemplate, error)
+	Update(*ClusterTemplate) (*ClusterTemplate, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*ClusterTemplateList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() ClusterTemplateController
+	AddHandler(name string, sync ClusterTemplateHandlerFunc)
+	AddLifecycle(name string, lifecycle ClusterTemplateLifecycle)
+	AddClusterScopedHandler(name, clusterName string, sync ClusterTemplateHandlerFunc)
+	AddClusterScopedLifecycle(name, clusterName string, lifecycle ClusterTemplateLifecycle)
+}
+
+type clusterTemplateLister struct {
+	controller *clusterTemplateController
+}
+
+func (l *clusterTemplateLister) List(namespace string, selector labels.Selector) (ret []*ClusterTemplate, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*ClusterTemplate))
+	})
+	return
+}
+
+func (l *clusterTemplateLister) Get(namespace, name string) (*ClusterTemplate, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ClusterTemplateGroupVersionKind.Group,
+			Resource: "clusterTemplate",
+		}, name)
+	}
+	return obj.(*ClusterTemplate), nil
+}
+
+type clusterTemplateController struct {
+	controller.GenericController
+}
+
+func (c *clusterTemplateController) Lister() ClusterTemplateLister {
+	return &clusterTemplateLister{
+		controller: c,
+	}
+}
+
+func (c *clusterTemplateController) AddHandler(name string, handler ClusterTemplateHandlerFunc) {
+	c.GenericController.AddHandler(name, func(key string) error {
+		obj, exists, err := c.Informer().GetStore().GetByKey(key)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return handler(key, nil)
+		}
+		return handler(key, obj.(*ClusterTemplate))
+	})
+}
+
+func (c *clusterTemplateController) AddClusterScopedHandler(name, cluster string, handler ClusterTemplateHandlerFunc) {
+	c.GenericController.AddHandler(name, func(key string) error {
+		obj, exists, err := c.Informer().GetStore().GetByKey(key)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return handler(key, nil)
+		}
+
+		if !controller.ObjectInCluster(cluster, obj) {
+			return nil
+		}
+
+		return handler(key, obj.(*ClusterTemplate))
+	})
+}
+
+type clusterTemplateFactory struct {
+}
+
+func (c clusterTemplateFactory) Object() runtime.Object {
+	return &ClusterTemplate{}
+}
+
+func (c clusterTemplateFactory) List() runtime.Object {
+	return &ClusterTemplateList{}
+}
+
+func (s *clusterTemplateClient) Controller() ClusterTemplateController {
+	s.client.Lock()
+	defer s.client.Unlock()
+
+	c, ok := s.client.clusterTemplateControllers[s.ns]
+	if ok {
+		return c
+	}
+
+	genericController := controller.NewGenericController(ClusterTemplateGroupVersionKind.Kind+"Controller",
+		s.objectClient)
+
+	c = &clusterTemplateController{
+		GenericController: genericController,
+	}
+
+	s.client.clusterTemplateControllers[s.ns] = c
+	s.client.starters = append(s.client.starters, c)
+
+	return c
+}
+
+type clusterTemplateClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   ClusterTemplateController
+}
+
+func (s *clusterTemplateClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *clusterTemplateClient) Create(o *ClusterTemplate) (*ClusterTemplate, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*ClusterTemplate), err
+}
+
+func (s *clusterTemplateClient) Get(name string, opts metav1.GetOptions) (*ClusterTemplate, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*ClusterTemplate), err
+}
+
+func (s *clusterTemplateClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*ClusterTemplate, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*ClusterTemplate), err
+}
+
+func (s *clusterTemplateClient) Update(o *ClusterTemplate) (*ClusterTemplate, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*ClusterTemplate), err
+}
+
+func (s *clusterTemplateClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *clusterTemplateClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterTemplateClient) List(opts metav1.ListOptions) (*ClusterTemplateList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*ClusterTemplateList), err
+}
+
+func (s *clusterTemplateClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *clusterTemplateClient) Patch(o *ClusterTemplate, data []byte, subresources ...string) (*ClusterTemplate, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, data, subresources...)
+	return obj.(*ClusterTemplate), err
+}
+
+func (s *clusterTemplateClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterTemplateClient) AddHandler(name string, sync ClusterTemplateHandlerFunc) {
+	s.Controller().AddHandler(name, sync)
+}
+
+func (s *clusterTemplateClient) AddLifecycle(name string, lifecycle ClusterTemplateLifecycle) {
+	sync := NewClusterTemplateLifecycleAdapter(name, false, s, lifecycle)
+	s.AddHandler(name, sync)
+}
+
+func (s *clusterTemplateClient) AddClusterScopedHandler(name, clusterName string, sync ClusterTemplateHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(name, clusterName, sync)
+}
+
+func (s *clusterTemplateClient) AddClusterScopedLifecycle(name, clusterName string, lifecycle ClusterTemplateLifecycle) {
+	sync := NewClusterTemplateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.AddClusterScopedHandler(name, clusterName, sync)
+}
@@ -0,0 +1,51 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ClusterTemplateLifecycle interface {
+	Create(obj *ClusterTemplate) (*ClusterTemplate, error)
+	Remove(obj *ClusterTemplate) (*ClusterTemplate, error)
+	Updated(obj *ClusterTemplate) (*ClusterTemplate, error)
+}
+
+type clusterTemplateLifecycleAdapter struct {
+	lifecycle ClusterTemplateLifecycle
+}
+
+func (w *clusterTemplateLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*ClusterTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterTemplateLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*ClusterTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterTemplateLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*ClusterTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewClusterTemplateLifecycleAdapter(name string, clusterScoped bool, client ClusterTemplateInterface, l ClusterTemplateLifecycle) ClusterTemplateHandlerFunc {
+	adapter := &clusterTemplateLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *ClusterTemplate) error {
+		if obj == nil {
+			return syncFn(key, nil)
+		}
+		return syncFn(key, obj)
+	}
+}
@@ -0,0 +1,251 @@
+package v3
+
+import (
+	"context"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/objectclient"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	ClusterTemplateRevisionGroupVersionKind = schema.GroupVersionKind{
+		Version: Version,
+		Group:   GroupName,
+		Kind:    "ClusterTemplateRevision",
+	}
+	ClusterTemplateRevisionResource = metav1.APIResource{
+		Name:         "clustertemplaterevisions",
+		SingularName: "clustertemplaterevision",
+		Namespaced:   false,
+		Kind:         ClusterTemplateRevisionGroupVersionKind.Kind,
+	}
+)
+
+type ClusterTemplateRevisionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterTemplateRevision
+}
+
+type ClusterTemplateRevisionHandlerFunc func(key string, obj *ClusterTemplateRevision) error
+
+type ClusterTemplateRevisionLister interface {
+	List(namespace string, selector labels.Selector) (ret []*ClusterTemplateRevision, err error)
+	Get(namespace, name string) (*ClusterTemplateRevision, error)
+}
+
+type ClusterTemplateRevisionController interface {
+	Informer() cache.SharedIndexInformer
+	Lister() ClusterTemplateRevisionLister
+	AddHandler(name string, handler ClusterTemplateRevisionHandlerFunc)
+	AddClusterScopedHandler(name, clusterName string, handler ClusterTemplateRevisionHandlerFunc)
+	Enqueue(namespace, name string)
+	Sync(ctx context.Context) error
+	Start(ctx context.Context, threadiness int) error
+}
+
+type ClusterTemplateRevisionInterface interface {
+	ObjectClient() *objectclient.ObjectClient
+	Create(*ClusterTemplateRevision) (*ClusterTemplateRevision, error)
+	GetNamespaced(namespace, name string, opts metav1.GetOptions) (*ClusterTemplateRevision, error)
+	Get(name string, opts metav1.GetOptions) (*ClusterTemplateRevision, error)
+	Update(*ClusterTemplateRevision) (*ClusterTemplateRevision, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*ClusterTemplateRevisionList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+	DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Controller() ClusterTemplateRevisionController
+	AddHandler(name string, sync ClusterTemplateRevisionHandlerFunc)
+	AddLifecycle(name string, lifecycle ClusterTemplateRevisionLifecycle)
+	AddClusterScopedHandler(name, clusterName string, sync ClusterTemplateRevisionHandlerFunc)
+	AddClusterScopedLifecycle(name, clusterName string, lifecycle ClusterTemplateRevisionLifecycle)
+}
+
+type clusterTemplateRevisionLister struct {
+	controller *clusterTemplateRevisionController
+}
+
+func (l *clusterTemplateRevisionLister) List(namespace string, selector labels.Selector) (ret []*ClusterTemplateRevision, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*ClusterTemplateRevision))
+	})
+	return
+}
+
+func (l *clusterTemplateRevisionLister) Get(namespace, name string) (*ClusterTemplateRevision, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    ClusterTemplateRevisionGroupVersionKind.Group,
+			Resource: "clusterTemplateRevision",
+		}, name)
+	}
+	return obj.(*ClusterTemplateRevision), nil
+}
+
+type clusterTemplateRevisionController struct {
+	controller.GenericController
+}
+
+func (c *clusterTemplateRevisionController) Lister() ClusterTemplateRevisionLister {
+	return &clusterTemplateRevisionLister{
+		controller: c,
+	}
+}
+
+func (c *clusterTemplateRevisionController) AddHandler(name string, handler ClusterTemplateRevisionHandlerFunc) {
+	c.GenericController.AddHandler(name, func(key string) error {
+		obj, exists, err := c.Informer().GetStore().GetByKey(key)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return handler(key, nil)
+		}
+		return handler(key, obj.(*ClusterTemplateRevision))
+	})
+}
+
+func (c *clusterTemplateRevisionController) AddClusterScopedHandler(name, cluster string, handler ClusterTemplateRevisionHandlerFunc) {
+	c.GenericController.AddHandler(name, func(key string) error {
+		obj, exists, err := c.Informer().GetStore().GetByKey(key)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return handler(key, nil)
+		}
+
+		if !controller.ObjectInCluster(cluster, obj) {
+			return nil
+		}
+
+		return handler(key, obj.(*ClusterTemplateRevision))
+	})
+}
+
+type clusterTemplateRevisionFactory struct {
+}
+
+func (c clusterTemplateRevisionFactory) Object() runtime.Object {
+	return &ClusterTemplateRevision{}
+}
+
+func (c clusterTemplateRevisionFactory) List() runtime.Object {
+	return &ClusterTemplateRevisionList{}
+}
+
+func (s *clusterTemplateRevisionClient) Controller() ClusterTemplateRevisionController {
+	s.client.Lock()
+	defer s.client.Unlock()
+
+	c, ok := s.client.clusterTemplateRevisionControllers[s.ns]
+	if ok {
+		return c
+	}
+
+	genericController := controller.NewGenericController(ClusterTemplateRevisionGroupVersionKind.Kind+"Controller",
+		s.objectClient)
+
+	c = &clusterTemplateRevisionController{
+		GenericController: genericController,
+	}
+
+	s.client.clusterTemplateRevisionControllers[s.ns] = c
+	s.client.starters = append(s.client.starters, c)
+
+	return c
+}
+
+type clusterTemplateRevisionClient struct {
+	client       *Client
+	ns           string
+	objectClient *objectclient.ObjectClient
+	controller   ClusterTemplateRevisionController
+}
+
+func (s *clusterTemplateRevisionClient) ObjectClient() *objectclient.ObjectClient {
+	return s.objectClient
+}
+
+func (s *clusterTemplateRevisionClient) Create(o *ClusterTemplateRevision) (*ClusterTemplateRevision, error) {
+	obj, err := s.objectClient.Create(o)
+	return obj.(*ClusterTemplateRevision), err
+}
+
+func (s *clusterTemplateRevisionClient) Get(name string, opts metav1.GetOptions) (*ClusterTemplateRevision, error) {
+	obj, err := s.objectClient.Get(name, opts)
+	return obj.(*ClusterTemplateRevision), err
+}
+
+func (s *clusterTemplateRevisionClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*ClusterTemplateRevision, error) {
+	obj, err := s.objectClient.GetNamespaced(namespace, name, opts)
+	return obj.(*ClusterTemplateRevision), err
+}
+
+func (s *clusterTemplateRevisionClient) Update(o *ClusterTemplateRevision) (*ClusterTemplateRevision, error) {
+	obj, err := s.objectClient.Update(o.Name, o)
+	return obj.(*ClusterTemplateRevision), err
+}
+
+func (s *clusterTemplateRevisionClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.Delete(name, options)
+}
+
+func (s *clusterTemplateRevisionClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.objectClient.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterTemplateRevisionClient) List(opts metav1.ListOptions) (*ClusterTemplateRevisionList, error) {
+	obj, err := s.objectClient.List(opts)
+	return obj.(*ClusterTemplateRevisionList), err
+}
+
+func (s *clusterTemplateRevisionClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.objectClient.Watch(opts)
+}
+
+// Patch applies the patch and returns the patched deployment.
+func (s *clusterTemplateRevisionClient) Patch(o *ClusterTemplateRevision, data []byte, subresources ...string) (*ClusterTemplateRevision, error) {
+	obj, err := s.objectClient.Patch(o.Name, o, data, subresources...)
+	return obj.(*ClusterTemplateRevision), err
+}
+
+func (s *clusterTemplateRevisionClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.objectClient.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterTemplateRevisionClient) AddHandler(name string, sync ClusterTemplateRevisionHandlerFunc) {
+	s.Controller().AddHandler(name, sync)
+}
+
+func (s *clusterTemplateRevisionClient) AddLifecycle(name string, lifecycle ClusterTemplateRevisionLifecycle) {
+	sync := NewClusterTemplateRevisionLifecycleAdapter(name, false, s, lifecycle)
+	s.AddHandler(name, sync)
+}
+
+func (s *clusterTemplateRevisionClient) AddClusterScopedHandler(name, clusterName string, sync ClusterTemplateRevisionHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(name, clusterName, sync)
+}
+
+func (s *clusterTemplateRevisionClient) AddClusterScopedLifecycle(name, clusterName string, lifecycle ClusterTemplateRevisionLifecycle) {
+	sync := NewClusterTemplateRevisionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.AddClusterScopedHandler(name, clusterName, sync)
+}
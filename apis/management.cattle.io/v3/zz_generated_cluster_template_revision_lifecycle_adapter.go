@@ -0,0 +1,51 @@
+package v3
+
+import (
+	"github.com/rancher/norman/lifecycle"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ClusterTemplateRevisionLifecycle interface {
+	Create(obj *ClusterTemplateRevision) (*ClusterTemplateRevision, error)
+	Remove(obj *ClusterTemplateRevision) (*ClusterTemplateRevision, error)
+	Updated(obj *ClusterTemplateRevision) (*ClusterTemplateRevision, error)
+}
+
+type clusterTemplateRevisionLifecycleAdapter struct {
+	lifecycle ClusterTemplateRevisionLifecycle
+}
+
+func (w *clusterTemplateRevisionLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*ClusterTemplateRevision))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterTemplateRevisionLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*ClusterTemplateRevision))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterTemplateRevisionLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*ClusterTemplateRevision))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func NewClusterTemplateRevisionLifecycleAdapter(name string, clusterScoped bool, client ClusterTemplateRevisionInterface, l ClusterTemplateRevisionLifecycle) ClusterTemplateRevisionHandlerFunc {
+	adapter := &clusterTemplateRevisionLifecycleAdapter{lifecycle: l}
+	syncFn := lifecycle.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.ObjectClient())
+	return func(key string, obj *ClusterTemplateRevision) error {
+		if obj == nil {
+			return syncFn(key, nil)
+		}
+		return syncFn(key, obj)
+	}
+}
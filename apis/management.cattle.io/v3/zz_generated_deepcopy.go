@@ -20,6 +20,10 @@ func init() {
 // Deprecated: deepcopy registration will go away when static deepcopy is fully implemented.
 func RegisterDeepCopies(scheme *runtime.Scheme) error {
 	return scheme.AddGeneratedDeepCopyFuncs(
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*AKSAgentPool).DeepCopyInto(out.(*AKSAgentPool))
+			return nil
+		}, InType: reflect.TypeOf(&AKSAgentPool{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*AWSCloudProvider).DeepCopyInto(out.(*AWSCloudProvider))
 			return nil
@@ -40,10 +44,18 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*AlertCommonSpec).DeepCopyInto(out.(*AlertCommonSpec))
 			return nil
 		}, InType: reflect.TypeOf(&AlertCommonSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*AlertGroupSpec).DeepCopyInto(out.(*AlertGroupSpec))
+			return nil
+		}, InType: reflect.TypeOf(&AlertGroupSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*AlertStatus).DeepCopyInto(out.(*AlertStatus))
 			return nil
 		}, InType: reflect.TypeOf(&AlertStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*AlertSuppression).DeepCopyInto(out.(*AlertSuppression))
+			return nil
+		}, InType: reflect.TypeOf(&AlertSuppression{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*AlertSystemImages).DeepCopyInto(out.(*AlertSystemImages))
 			return nil
@@ -52,6 +64,10 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*AmazonElasticContainerServiceConfig).DeepCopyInto(out.(*AmazonElasticContainerServiceConfig))
 			return nil
 		}, InType: reflect.TypeOf(&AmazonElasticContainerServiceConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*AuditLog).DeepCopyInto(out.(*AuditLog))
+			return nil
+		}, InType: reflect.TypeOf(&AuditLog{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*AuthAppInput).DeepCopyInto(out.(*AuthAppInput))
 			return nil
@@ -92,6 +108,10 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*AzureKubernetesServiceConfig).DeepCopyInto(out.(*AzureKubernetesServiceConfig))
 			return nil
 		}, InType: reflect.TypeOf(&AzureKubernetesServiceConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*BackupConfig).DeepCopyInto(out.(*BackupConfig))
+			return nil
+		}, InType: reflect.TypeOf(&BackupConfig{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*BaseService).DeepCopyInto(out.(*BaseService))
 			return nil
@@ -100,6 +120,10 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*BastionHost).DeepCopyInto(out.(*BastionHost))
 			return nil
 		}, InType: reflect.TypeOf(&BastionHost{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*BlockStorageOpenstackOpts).DeepCopyInto(out.(*BlockStorageOpenstackOpts))
+			return nil
+		}, InType: reflect.TypeOf(&BlockStorageOpenstackOpts{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*CalicoNetworkProvider).DeepCopyInto(out.(*CalicoNetworkProvider))
 			return nil
@@ -144,6 +168,18 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*ClusterAlert).DeepCopyInto(out.(*ClusterAlert))
 			return nil
 		}, InType: reflect.TypeOf(&ClusterAlert{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterAlertGroup).DeepCopyInto(out.(*ClusterAlertGroup))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterAlertGroup{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterAlertGroupList).DeepCopyInto(out.(*ClusterAlertGroupList))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterAlertGroupList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterAlertGroupSpec).DeepCopyInto(out.(*ClusterAlertGroupSpec))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterAlertGroupSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*ClusterAlertList).DeepCopyInto(out.(*ClusterAlertList))
 			return nil
@@ -152,6 +188,22 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*ClusterAlertSpec).DeepCopyInto(out.(*ClusterAlertSpec))
 			return nil
 		}, InType: reflect.TypeOf(&ClusterAlertSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterBackup).DeepCopyInto(out.(*ClusterBackup))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterBackup{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterBackupList).DeepCopyInto(out.(*ClusterBackupList))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterBackupList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterBackupSpec).DeepCopyInto(out.(*ClusterBackupSpec))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterBackupSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterBackupStatus).DeepCopyInto(out.(*ClusterBackupStatus))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterBackupStatus{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*ClusterComponentStatus).DeepCopyInto(out.(*ClusterComponentStatus))
 			return nil
@@ -248,6 +300,34 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*ClusterStatus).DeepCopyInto(out.(*ClusterStatus))
 			return nil
 		}, InType: reflect.TypeOf(&ClusterStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterTemplate).DeepCopyInto(out.(*ClusterTemplate))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterTemplate{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterTemplateList).DeepCopyInto(out.(*ClusterTemplateList))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterTemplateList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterTemplateRevision).DeepCopyInto(out.(*ClusterTemplateRevision))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterTemplateRevision{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterTemplateRevisionList).DeepCopyInto(out.(*ClusterTemplateRevisionList))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterTemplateRevisionList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterTemplateRevisionSpec).DeepCopyInto(out.(*ClusterTemplateRevisionSpec))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterTemplateRevisionSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterTemplateSpec).DeepCopyInto(out.(*ClusterTemplateSpec))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterTemplateSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ClusterUpgradeStatus).DeepCopyInto(out.(*ClusterUpgradeStatus))
+			return nil
+		}, InType: reflect.TypeOf(&ClusterUpgradeStatus{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*ComposeCondition).DeepCopyInto(out.(*ComposeCondition))
 			return nil
@@ -264,10 +344,22 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*Condition).DeepCopyInto(out.(*Condition))
 			return nil
 		}, InType: reflect.TypeOf(&Condition{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ContainerResourceLimit).DeepCopyInto(out.(*ContainerResourceLimit))
+			return nil
+		}, InType: reflect.TypeOf(&ContainerResourceLimit{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*CustomConfig).DeepCopyInto(out.(*CustomConfig))
 			return nil
 		}, InType: reflect.TypeOf(&CustomConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*DNSConfig).DeepCopyInto(out.(*DNSConfig))
+			return nil
+		}, InType: reflect.TypeOf(&DNSConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*DiskVsphereOpts).DeepCopyInto(out.(*DiskVsphereOpts))
+			return nil
+		}, InType: reflect.TypeOf(&DiskVsphereOpts{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*DockerInfo).DeepCopyInto(out.(*DockerInfo))
 			return nil
@@ -300,10 +392,22 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*EmbeddedConfig).DeepCopyInto(out.(*EmbeddedConfig))
 			return nil
 		}, InType: reflect.TypeOf(&EmbeddedConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*EncryptionKey).DeepCopyInto(out.(*EncryptionKey))
+			return nil
+		}, InType: reflect.TypeOf(&EncryptionKey{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*EncryptionProvider).DeepCopyInto(out.(*EncryptionProvider))
+			return nil
+		}, InType: reflect.TypeOf(&EncryptionProvider{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*Field).DeepCopyInto(out.(*Field))
 			return nil
 		}, InType: reflect.TypeOf(&Field{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*FieldError).DeepCopyInto(out.(*FieldError))
+			return nil
+		}, InType: reflect.TypeOf(&FieldError{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*File).DeepCopyInto(out.(*File))
 			return nil
@@ -316,6 +420,26 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*FlannelNetworkProvider).DeepCopyInto(out.(*FlannelNetworkProvider))
 			return nil
 		}, InType: reflect.TypeOf(&FlannelNetworkProvider{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*GKECidrBlock).DeepCopyInto(out.(*GKECidrBlock))
+			return nil
+		}, InType: reflect.TypeOf(&GKECidrBlock{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*GKEMasterAuthorizedNetworks).DeepCopyInto(out.(*GKEMasterAuthorizedNetworks))
+			return nil
+		}, InType: reflect.TypeOf(&GKEMasterAuthorizedNetworks{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*GKENodePool).DeepCopyInto(out.(*GKENodePool))
+			return nil
+		}, InType: reflect.TypeOf(&GKENodePool{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*GKENodePoolAutoscaling).DeepCopyInto(out.(*GKENodePoolAutoscaling))
+			return nil
+		}, InType: reflect.TypeOf(&GKENodePoolAutoscaling{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*GKEPrivateCluster).DeepCopyInto(out.(*GKEPrivateCluster))
+			return nil
+		}, InType: reflect.TypeOf(&GKEPrivateCluster{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*GenerateKubeConfigOutput).DeepCopyInto(out.(*GenerateKubeConfigOutput))
 			return nil
@@ -336,6 +460,10 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*GithubConfigTestOutput).DeepCopyInto(out.(*GithubConfigTestOutput))
 			return nil
 		}, InType: reflect.TypeOf(&GithubConfigTestOutput{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*GlobalAwsOpts).DeepCopyInto(out.(*GlobalAwsOpts))
+			return nil
+		}, InType: reflect.TypeOf(&GlobalAwsOpts{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*GlobalComposeConfig).DeepCopyInto(out.(*GlobalComposeConfig))
 			return nil
@@ -344,6 +472,10 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*GlobalComposeConfigList).DeepCopyInto(out.(*GlobalComposeConfigList))
 			return nil
 		}, InType: reflect.TypeOf(&GlobalComposeConfigList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*GlobalOpenstackOpts).DeepCopyInto(out.(*GlobalOpenstackOpts))
+			return nil
+		}, InType: reflect.TypeOf(&GlobalOpenstackOpts{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*GlobalRole).DeepCopyInto(out.(*GlobalRole))
 			return nil
@@ -360,6 +492,10 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*GlobalRoleList).DeepCopyInto(out.(*GlobalRoleList))
 			return nil
 		}, InType: reflect.TypeOf(&GlobalRoleList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*GlobalVsphereOpts).DeepCopyInto(out.(*GlobalVsphereOpts))
+			return nil
+		}, InType: reflect.TypeOf(&GlobalVsphereOpts{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*GoogleKubernetesEngineConfig).DeepCopyInto(out.(*GoogleKubernetesEngineConfig))
 			return nil
@@ -380,6 +516,14 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*GroupMemberList).DeepCopyInto(out.(*GroupMemberList))
 			return nil
 		}, InType: reflect.TypeOf(&GroupMemberList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*HTTPBasicAuth).DeepCopyInto(out.(*HTTPBasicAuth))
+			return nil
+		}, InType: reflect.TypeOf(&HTTPBasicAuth{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*HTTPTLSConfig).DeepCopyInto(out.(*HTTPTLSConfig))
+			return nil
+		}, InType: reflect.TypeOf(&HTTPTLSConfig{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*HealthCheck).DeepCopyInto(out.(*HealthCheck))
 			return nil
@@ -400,10 +544,18 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*IngressConfig).DeepCopyInto(out.(*IngressConfig))
 			return nil
 		}, InType: reflect.TypeOf(&IngressConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*InhibitRule).DeepCopyInto(out.(*InhibitRule))
+			return nil
+		}, InType: reflect.TypeOf(&InhibitRule{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*KafkaConfig).DeepCopyInto(out.(*KafkaConfig))
 			return nil
 		}, InType: reflect.TypeOf(&KafkaConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*KeyRotation).DeepCopyInto(out.(*KeyRotation))
+			return nil
+		}, InType: reflect.TypeOf(&KeyRotation{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*KubeAPIService).DeepCopyInto(out.(*KubeAPIService))
 			return nil
@@ -436,10 +588,18 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*ListenConfigList).DeepCopyInto(out.(*ListenConfigList))
 			return nil
 		}, InType: reflect.TypeOf(&ListenConfigList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*LoadBalancerOpenstackOpts).DeepCopyInto(out.(*LoadBalancerOpenstackOpts))
+			return nil
+		}, InType: reflect.TypeOf(&LoadBalancerOpenstackOpts{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*LocalConfig).DeepCopyInto(out.(*LocalConfig))
 			return nil
 		}, InType: reflect.TypeOf(&LocalConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*LogLevelExtraction).DeepCopyInto(out.(*LogLevelExtraction))
+			return nil
+		}, InType: reflect.TypeOf(&LogLevelExtraction{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*LoggingCommonSpec).DeepCopyInto(out.(*LoggingCommonSpec))
 			return nil
@@ -448,14 +608,70 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*LoggingCondition).DeepCopyInto(out.(*LoggingCondition))
 			return nil
 		}, InType: reflect.TypeOf(&LoggingCondition{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*LoggingFilter).DeepCopyInto(out.(*LoggingFilter))
+			return nil
+		}, InType: reflect.TypeOf(&LoggingFilter{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*LoggingSelector).DeepCopyInto(out.(*LoggingSelector))
+			return nil
+		}, InType: reflect.TypeOf(&LoggingSelector{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*LoggingSystemImages).DeepCopyInto(out.(*LoggingSystemImages))
 			return nil
 		}, InType: reflect.TypeOf(&LoggingSystemImages{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*MSTeamsConfig).DeepCopyInto(out.(*MSTeamsConfig))
+			return nil
+		}, InType: reflect.TypeOf(&MSTeamsConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*MessageTemplatePreviewInput).DeepCopyInto(out.(*MessageTemplatePreviewInput))
+			return nil
+		}, InType: reflect.TypeOf(&MessageTemplatePreviewInput{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*MessageTemplatePreviewOutput).DeepCopyInto(out.(*MessageTemplatePreviewOutput))
+			return nil
+		}, InType: reflect.TypeOf(&MessageTemplatePreviewOutput{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*MetadataOpenstackOpts).DeepCopyInto(out.(*MetadataOpenstackOpts))
+			return nil
+		}, InType: reflect.TypeOf(&MetadataOpenstackOpts{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*MetricRule).DeepCopyInto(out.(*MetricRule))
+			return nil
+		}, InType: reflect.TypeOf(&MetricRule{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*MonitorMetric).DeepCopyInto(out.(*MonitorMetric))
+			return nil
+		}, InType: reflect.TypeOf(&MonitorMetric{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*MonitorMetricList).DeepCopyInto(out.(*MonitorMetricList))
+			return nil
+		}, InType: reflect.TypeOf(&MonitorMetricList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*MonitorMetricSpec).DeepCopyInto(out.(*MonitorMetricSpec))
+			return nil
+		}, InType: reflect.TypeOf(&MonitorMetricSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*MonitoringConfig).DeepCopyInto(out.(*MonitoringConfig))
+			return nil
+		}, InType: reflect.TypeOf(&MonitoringConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*MonitoringSystemImages).DeepCopyInto(out.(*MonitoringSystemImages))
+			return nil
+		}, InType: reflect.TypeOf(&MonitoringSystemImages{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*NamespaceResourceQuota).DeepCopyInto(out.(*NamespaceResourceQuota))
+			return nil
+		}, InType: reflect.TypeOf(&NamespaceResourceQuota{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*NetworkConfig).DeepCopyInto(out.(*NetworkConfig))
 			return nil
 		}, InType: reflect.TypeOf(&NetworkConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*NetworkVsphereOpts).DeepCopyInto(out.(*NetworkVsphereOpts))
+			return nil
+		}, InType: reflect.TypeOf(&NetworkVsphereOpts{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*Node).DeepCopyInto(out.(*Node))
 			return nil
@@ -468,6 +684,10 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*NodeCondition).DeepCopyInto(out.(*NodeCondition))
 			return nil
 		}, InType: reflect.TypeOf(&NodeCondition{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*NodeDrainInput).DeepCopyInto(out.(*NodeDrainInput))
+			return nil
+		}, InType: reflect.TypeOf(&NodeDrainInput{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*NodeDriver).DeepCopyInto(out.(*NodeDriver))
 			return nil
@@ -488,6 +708,10 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*NodeList).DeepCopyInto(out.(*NodeList))
 			return nil
 		}, InType: reflect.TypeOf(&NodeList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*NodePlanChange).DeepCopyInto(out.(*NodePlanChange))
+			return nil
+		}, InType: reflect.TypeOf(&NodePlanChange{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*NodePool).DeepCopyInto(out.(*NodePool))
 			return nil
@@ -532,6 +756,14 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*NodeTemplateStatus).DeepCopyInto(out.(*NodeTemplateStatus))
 			return nil
 		}, InType: reflect.TypeOf(&NodeTemplateStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*NodeUpgradeProgress).DeepCopyInto(out.(*NodeUpgradeProgress))
+			return nil
+		}, InType: reflect.TypeOf(&NodeUpgradeProgress{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*NodeUpgradeStrategy).DeepCopyInto(out.(*NodeUpgradeStrategy))
+			return nil
+		}, InType: reflect.TypeOf(&NodeUpgradeStrategy{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*Notification).DeepCopyInto(out.(*Notification))
 			return nil
@@ -544,6 +776,10 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*NotifierList).DeepCopyInto(out.(*NotifierList))
 			return nil
 		}, InType: reflect.TypeOf(&NotifierList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*NotifierSendAttempt).DeepCopyInto(out.(*NotifierSendAttempt))
+			return nil
+		}, InType: reflect.TypeOf(&NotifierSendAttempt{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*NotifierSpec).DeepCopyInto(out.(*NotifierSpec))
 			return nil
@@ -552,6 +788,14 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*NotifierStatus).DeepCopyInto(out.(*NotifierStatus))
 			return nil
 		}, InType: reflect.TypeOf(&NotifierStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*OpenstackCloudProvider).DeepCopyInto(out.(*OpenstackCloudProvider))
+			return nil
+		}, InType: reflect.TypeOf(&OpenstackCloudProvider{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*OpsgenieConfig).DeepCopyInto(out.(*OpsgenieConfig))
+			return nil
+		}, InType: reflect.TypeOf(&OpsgenieConfig{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*PagerdutyConfig).DeepCopyInto(out.(*PagerdutyConfig))
 			return nil
@@ -652,6 +896,14 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*Process).DeepCopyInto(out.(*Process))
 			return nil
 		}, InType: reflect.TypeOf(&Process{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ProcessChange).DeepCopyInto(out.(*ProcessChange))
+			return nil
+		}, InType: reflect.TypeOf(&ProcessChange{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ProcessFieldChange).DeepCopyInto(out.(*ProcessFieldChange))
+			return nil
+		}, InType: reflect.TypeOf(&ProcessFieldChange{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*Project).DeepCopyInto(out.(*Project))
 			return nil
@@ -660,6 +912,18 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*ProjectAlert).DeepCopyInto(out.(*ProjectAlert))
 			return nil
 		}, InType: reflect.TypeOf(&ProjectAlert{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ProjectAlertGroup).DeepCopyInto(out.(*ProjectAlertGroup))
+			return nil
+		}, InType: reflect.TypeOf(&ProjectAlertGroup{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ProjectAlertGroupList).DeepCopyInto(out.(*ProjectAlertGroupList))
+			return nil
+		}, InType: reflect.TypeOf(&ProjectAlertGroupList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ProjectAlertGroupSpec).DeepCopyInto(out.(*ProjectAlertGroupSpec))
+			return nil
+		}, InType: reflect.TypeOf(&ProjectAlertGroupSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*ProjectAlertList).DeepCopyInto(out.(*ProjectAlertList))
 			return nil
@@ -708,6 +972,14 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*ProjectNetworkPolicyStatus).DeepCopyInto(out.(*ProjectNetworkPolicyStatus))
 			return nil
 		}, InType: reflect.TypeOf(&ProjectNetworkPolicyStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ProjectResourceQuota).DeepCopyInto(out.(*ProjectResourceQuota))
+			return nil
+		}, InType: reflect.TypeOf(&ProjectResourceQuota{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ProjectResourceQuotaUsage).DeepCopyInto(out.(*ProjectResourceQuotaUsage))
+			return nil
+		}, InType: reflect.TypeOf(&ProjectResourceQuotaUsage{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*ProjectRoleTemplateBinding).DeepCopyInto(out.(*ProjectRoleTemplateBinding))
 			return nil
@@ -768,6 +1040,14 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*RepoPerm).DeepCopyInto(out.(*RepoPerm))
 			return nil
 		}, InType: reflect.TypeOf(&RepoPerm{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ResourceQuotaLimit).DeepCopyInto(out.(*ResourceQuotaLimit))
+			return nil
+		}, InType: reflect.TypeOf(&ResourceQuotaLimit{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*RestoreFromEtcdBackupInput).DeepCopyInto(out.(*RestoreFromEtcdBackupInput))
+			return nil
+		}, InType: reflect.TypeOf(&RestoreFromEtcdBackupInput{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*RoleTemplate).DeepCopyInto(out.(*RoleTemplate))
 			return nil
@@ -776,6 +1056,10 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*RoleTemplateList).DeepCopyInto(out.(*RoleTemplateList))
 			return nil
 		}, InType: reflect.TypeOf(&RoleTemplateList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*RouteOpenstackOpts).DeepCopyInto(out.(*RouteOpenstackOpts))
+			return nil
+		}, InType: reflect.TypeOf(&RouteOpenstackOpts{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*RunPipelineInput).DeepCopyInto(out.(*RunPipelineInput))
 			return nil
@@ -784,6 +1068,10 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*RunScriptConfig).DeepCopyInto(out.(*RunScriptConfig))
 			return nil
 		}, InType: reflect.TypeOf(&RunScriptConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*S3BackupConfig).DeepCopyInto(out.(*S3BackupConfig))
+			return nil
+		}, InType: reflect.TypeOf(&S3BackupConfig{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*SMTPConfig).DeepCopyInto(out.(*SMTPConfig))
 			return nil
@@ -796,6 +1084,18 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*SearchPrincipalsInput).DeepCopyInto(out.(*SearchPrincipalsInput))
 			return nil
 		}, InType: reflect.TypeOf(&SearchPrincipalsInput{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*SecretKeyRef).DeepCopyInto(out.(*SecretKeyRef))
+			return nil
+		}, InType: reflect.TypeOf(&SecretKeyRef{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*SecretsEncryptionConfig).DeepCopyInto(out.(*SecretsEncryptionConfig))
+			return nil
+		}, InType: reflect.TypeOf(&SecretsEncryptionConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ServiceOverride).DeepCopyInto(out.(*ServiceOverride))
+			return nil
+		}, InType: reflect.TypeOf(&ServiceOverride{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*SetPasswordInput).DeepCopyInto(out.(*SetPasswordInput))
 			return nil
@@ -812,6 +1112,10 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*SettingList).DeepCopyInto(out.(*SettingList))
 			return nil
 		}, InType: reflect.TypeOf(&SettingList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*SilenceWindow).DeepCopyInto(out.(*SilenceWindow))
+			return nil
+		}, InType: reflect.TypeOf(&SilenceWindow{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*SlackConfig).DeepCopyInto(out.(*SlackConfig))
 			return nil
@@ -956,6 +1260,10 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*UserList).DeepCopyInto(out.(*UserList))
 			return nil
 		}, InType: reflect.TypeOf(&UserList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*ValidationResult).DeepCopyInto(out.(*ValidationResult))
+			return nil
+		}, InType: reflect.TypeOf(&ValidationResult{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*Values).DeepCopyInto(out.(*Values))
 			return nil
@@ -964,16 +1272,52 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 			in.(*VersionCommits).DeepCopyInto(out.(*VersionCommits))
 			return nil
 		}, InType: reflect.TypeOf(&VersionCommits{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*VirtualCenterConfig).DeepCopyInto(out.(*VirtualCenterConfig))
+			return nil
+		}, InType: reflect.TypeOf(&VirtualCenterConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*VsphereCloudProvider).DeepCopyInto(out.(*VsphereCloudProvider))
+			return nil
+		}, InType: reflect.TypeOf(&VsphereCloudProvider{})},
 		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
 			in.(*WebhookConfig).DeepCopyInto(out.(*WebhookConfig))
 			return nil
 		}, InType: reflect.TypeOf(&WebhookConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: func(in interface{}, out interface{}, c *conversion.Cloner) error {
+			in.(*WorkspaceVsphereOpts).DeepCopyInto(out.(*WorkspaceVsphereOpts))
+			return nil
+		}, InType: reflect.TypeOf(&WorkspaceVsphereOpts{})},
 	)
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AKSAgentPool) DeepCopyInto(out *AKSAgentPool) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AKSAgentPool.
+func (in *AKSAgentPool) DeepCopy() *AKSAgentPool {
+	if in == nil {
+		return nil
+	}
+	out := new(AKSAgentPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSCloudProvider) DeepCopyInto(out *AWSCloudProvider) {
 	*out = *in
+	out.Global = in.Global
+	if in.ServiceOverride != nil {
+		in, out := &in.ServiceOverride, &out.ServiceOverride
+		*out = make(map[string]ServiceOverride, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1061,6 +1405,18 @@ func (in *AlertCommonSpec) DeepCopyInto(out *AlertCommonSpec) {
 		*out = make([]Recipient, len(*in))
 		copy(*out, *in)
 	}
+	if in.InhibitRules != nil {
+		in, out := &in.InhibitRules, &out.InhibitRules
+		*out = make([]InhibitRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SilenceWindows != nil {
+		in, out := &in.SilenceWindows, &out.SilenceWindows
+		*out = make([]SilenceWindow, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1074,9 +1430,39 @@ func (in *AlertCommonSpec) DeepCopy() *AlertCommonSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertGroupSpec) DeepCopyInto(out *AlertGroupSpec) {
+	*out = *in
+	if in.GroupBy != nil {
+		in, out := &in.GroupBy, &out.GroupBy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertGroupSpec.
+func (in *AlertGroupSpec) DeepCopy() *AlertGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertStatus) DeepCopyInto(out *AlertStatus) {
 	*out = *in
+	if in.Suppression != nil {
+		in, out := &in.Suppression, &out.Suppression
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(AlertSuppression)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -1090,6 +1476,22 @@ func (in *AlertStatus) DeepCopy() *AlertStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSuppression) DeepCopyInto(out *AlertSuppression) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSuppression.
+func (in *AlertSuppression) DeepCopy() *AlertSuppression {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSuppression)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertSystemImages) DeepCopyInto(out *AlertSystemImages) {
 	*out = *in
@@ -1109,6 +1511,16 @@ func (in *AlertSystemImages) DeepCopy() *AlertSystemImages {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AmazonElasticContainerServiceConfig) DeepCopyInto(out *AmazonElasticContainerServiceConfig) {
 	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1122,6 +1534,22 @@ func (in *AmazonElasticContainerServiceConfig) DeepCopy() *AmazonElasticContaine
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLog) DeepCopyInto(out *AuditLog) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLog.
+func (in *AuditLog) DeepCopy() *AuditLog {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthAppInput) DeepCopyInto(out *AuthAppInput) {
 	*out = *in
@@ -1319,6 +1747,24 @@ func (in *AzureADTestAndApplyInput) DeepCopy() *AzureADTestAndApplyInput {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureCloudProvider) DeepCopyInto(out *AzureCloudProvider) {
 	*out = *in
+	if in.AADClientSecretRef != nil {
+		in, out := &in.AADClientSecretRef, &out.AADClientSecretRef
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SecretKeyRef)
+			**out = **in
+		}
+	}
+	if in.AADClientCertPasswordRef != nil {
+		in, out := &in.AADClientCertPasswordRef, &out.AADClientCertPasswordRef
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SecretKeyRef)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -1342,6 +1788,20 @@ func (in *AzureKubernetesServiceConfig) DeepCopyInto(out *AzureKubernetesService
 			(*out)[key] = val
 		}
 	}
+	if in.AgentPools != nil {
+		in, out := &in.AgentPools, &out.AgentPools
+		*out = make([]AKSAgentPool, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnableRBAC != nil {
+		in, out := &in.EnableRBAC, &out.EnableRBAC
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -1356,9 +1816,43 @@ func (in *AzureKubernetesServiceConfig) DeepCopy() *AzureKubernetesServiceConfig
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BaseService) DeepCopyInto(out *BaseService) {
+func (in *BackupConfig) DeepCopyInto(out *BackupConfig) {
 	*out = *in
-	if in.ExtraArgs != nil {
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
+	if in.S3BackupConfig != nil {
+		in, out := &in.S3BackupConfig, &out.S3BackupConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(S3BackupConfig)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupConfig.
+func (in *BackupConfig) DeepCopy() *BackupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BaseService) DeepCopyInto(out *BaseService) {
+	*out = *in
+	if in.ExtraArgs != nil {
 		in, out := &in.ExtraArgs, &out.ExtraArgs
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
@@ -1386,6 +1880,15 @@ func (in *BaseService) DeepCopy() *BaseService {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionHost) DeepCopyInto(out *BastionHost) {
 	*out = *in
+	if in.SSHKeySecretRef != nil {
+		in, out := &in.SSHKeySecretRef, &out.SSHKeySecretRef
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SecretKeyRef)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -1399,6 +1902,22 @@ func (in *BastionHost) DeepCopy() *BastionHost {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockStorageOpenstackOpts) DeepCopyInto(out *BlockStorageOpenstackOpts) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockStorageOpenstackOpts.
+func (in *BlockStorageOpenstackOpts) DeepCopy() *BlockStorageOpenstackOpts {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockStorageOpenstackOpts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CalicoNetworkProvider) DeepCopyInto(out *CalicoNetworkProvider) {
 	*out = *in
@@ -1587,7 +2106,7 @@ func (in *CloudProvider) DeepCopyInto(out *CloudProvider) {
 			*out = nil
 		} else {
 			*out = new(AWSCloudProvider)
-			**out = **in
+			(*in).DeepCopyInto(*out)
 		}
 	}
 	if in.AzureCloudProvider != nil {
@@ -1596,7 +2115,25 @@ func (in *CloudProvider) DeepCopyInto(out *CloudProvider) {
 			*out = nil
 		} else {
 			*out = new(AzureCloudProvider)
-			**out = **in
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.VsphereCloudProvider != nil {
+		in, out := &in.VsphereCloudProvider, &out.VsphereCloudProvider
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(VsphereCloudProvider)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.OpenstackCloudProvider != nil {
+		in, out := &in.OpenstackCloudProvider, &out.OpenstackCloudProvider
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(OpenstackCloudProvider)
+			(*in).DeepCopyInto(*out)
 		}
 	}
 	return
@@ -1648,7 +2185,7 @@ func (in *ClusterAlert) DeepCopyInto(out *ClusterAlert) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -1671,6 +2208,86 @@ func (in *ClusterAlert) DeepCopyObject() runtime.Object {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAlertGroup) DeepCopyInto(out *ClusterAlertGroup) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertGroup.
+func (in *ClusterAlertGroup) DeepCopy() *ClusterAlertGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAlertGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAlertGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAlertGroupList) DeepCopyInto(out *ClusterAlertGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAlertGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertGroupList.
+func (in *ClusterAlertGroupList) DeepCopy() *ClusterAlertGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAlertGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAlertGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAlertGroupSpec) DeepCopyInto(out *ClusterAlertGroupSpec) {
+	*out = *in
+	in.AlertGroupSpec.DeepCopyInto(&out.AlertGroupSpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertGroupSpec.
+func (in *ClusterAlertGroupSpec) DeepCopy() *ClusterAlertGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAlertGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterAlertList) DeepCopyInto(out *ClusterAlertList) {
 	*out = *in
@@ -1736,6 +2353,15 @@ func (in *ClusterAlertSpec) DeepCopyInto(out *ClusterAlertSpec) {
 			**out = **in
 		}
 	}
+	if in.TargetMetric != nil {
+		in, out := &in.TargetMetric, &out.TargetMetric
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MetricRule)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -1749,6 +2375,108 @@ func (in *ClusterAlertSpec) DeepCopy() *ClusterAlertSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBackup) DeepCopyInto(out *ClusterBackup) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBackup.
+func (in *ClusterBackup) DeepCopy() *ClusterBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBackupList) DeepCopyInto(out *ClusterBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBackupList.
+func (in *ClusterBackupList) DeepCopy() *ClusterBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBackupSpec) DeepCopyInto(out *ClusterBackupSpec) {
+	*out = *in
+	in.BackupConfig.DeepCopyInto(&out.BackupConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBackupSpec.
+func (in *ClusterBackupSpec) DeepCopy() *ClusterBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBackupStatus) DeepCopyInto(out *ClusterBackupStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBackupStatus.
+func (in *ClusterBackupStatus) DeepCopy() *ClusterBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterComponentStatus) DeepCopyInto(out *ClusterComponentStatus) {
 	*out = *in
@@ -2390,7 +3118,23 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 			*out = nil
 		} else {
 			*out = new(AmazonElasticContainerServiceConfig)
-			**out = **in
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.ClusterTemplateAnswers != nil {
+		in, out := &in.ClusterTemplateAnswers, &out.ClusterTemplateAnswers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MonitoringConfig != nil {
+		in, out := &in.MonitoringConfig, &out.MonitoringConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MonitoringConfig)
+			(*in).DeepCopyInto(*out)
 		}
 	}
 	return
@@ -2468,6 +3212,22 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 			**out = **in
 		}
 	}
+	if in.UpgradeStatus != nil {
+		in, out := &in.UpgradeStatus, &out.UpgradeStatus
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ClusterUpgradeStatus)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.PendingPlanChanges != nil {
+		in, out := &in.PendingPlanChanges, &out.PendingPlanChanges
+		*out = make([]NodePlanChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2482,23 +3242,227 @@ func (in *ClusterStatus) DeepCopy() *ClusterStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComposeCondition) DeepCopyInto(out *ComposeCondition) {
+func (in *ClusterTemplate) DeepCopyInto(out *ClusterTemplate) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComposeCondition.
-func (in *ComposeCondition) DeepCopy() *ComposeCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplate.
+func (in *ClusterTemplate) DeepCopy() *ClusterTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(ComposeCondition)
+	out := new(ClusterTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ComposeSpec) DeepCopyInto(out *ComposeSpec) {
+func (in *ClusterTemplateList) DeepCopyInto(out *ClusterTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateList.
+func (in *ClusterTemplateList) DeepCopy() *ClusterTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateRevision) DeepCopyInto(out *ClusterTemplateRevision) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateRevision.
+func (in *ClusterTemplateRevision) DeepCopy() *ClusterTemplateRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplateRevision) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateRevisionList) DeepCopyInto(out *ClusterTemplateRevisionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterTemplateRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateRevisionList.
+func (in *ClusterTemplateRevisionList) DeepCopy() *ClusterTemplateRevisionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateRevisionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplateRevisionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateRevisionSpec) DeepCopyInto(out *ClusterTemplateRevisionSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
+	if in.ClusterConfig != nil {
+		in, out := &in.ClusterConfig, &out.ClusterConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ClusterSpec)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.Questions != nil {
+		in, out := &in.Questions, &out.Questions
+		*out = make([]Question, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateRevisionSpec.
+func (in *ClusterTemplateRevisionSpec) DeepCopy() *ClusterTemplateRevisionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateRevisionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateSpec) DeepCopyInto(out *ClusterTemplateSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateSpec.
+func (in *ClusterTemplateSpec) DeepCopy() *ClusterTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUpgradeStatus) DeepCopyInto(out *ClusterUpgradeStatus) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]NodeUpgradeProgress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgradeStatus.
+func (in *ClusterUpgradeStatus) DeepCopy() *ClusterUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComposeCondition) DeepCopyInto(out *ComposeCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComposeCondition.
+func (in *ComposeCondition) DeepCopy() *ComposeCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ComposeCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComposeSpec) DeepCopyInto(out *ComposeSpec) {
 	*out = *in
 	return
 }
@@ -2550,6 +3514,22 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerResourceLimit) DeepCopyInto(out *ContainerResourceLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerResourceLimit.
+func (in *ContainerResourceLimit) DeepCopy() *ContainerResourceLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerResourceLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomConfig) DeepCopyInto(out *CustomConfig) {
 	*out = *in
@@ -2566,6 +3546,62 @@ func (in *CustomConfig) DeepCopy() *CustomConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSConfig) DeepCopyInto(out *DNSConfig) {
+	*out = *in
+	if in.UpstreamNameservers != nil {
+		in, out := &in.UpstreamNameservers, &out.UpstreamNameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StubDomains != nil {
+		in, out := &in.StubDomains, &out.StubDomains
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				(*out)[key] = make([]string, len(val))
+				copy((*out)[key], val)
+			}
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSConfig.
+func (in *DNSConfig) DeepCopy() *DNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskVsphereOpts) DeepCopyInto(out *DiskVsphereOpts) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskVsphereOpts.
+func (in *DiskVsphereOpts) DeepCopy() *DiskVsphereOpts {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskVsphereOpts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DockerInfo) DeepCopyInto(out *DockerInfo) {
 	*out = *in
@@ -2741,6 +3777,15 @@ func (in *ETCDService) DeepCopyInto(out *ETCDService) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.BackupConfig != nil {
+		in, out := &in.BackupConfig, &out.BackupConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(BackupConfig)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -2786,6 +3831,43 @@ func (in *EmbeddedConfig) DeepCopy() *EmbeddedConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionKey) DeepCopyInto(out *EncryptionKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKey.
+func (in *EncryptionKey) DeepCopy() *EncryptionKey {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionProvider) DeepCopyInto(out *EncryptionProvider) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]EncryptionKey, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionProvider.
+func (in *EncryptionProvider) DeepCopy() *EncryptionProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Field) DeepCopyInto(out *Field) {
 	*out = *in
@@ -2808,6 +3890,22 @@ func (in *Field) DeepCopy() *Field {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldError) DeepCopyInto(out *FieldError) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldError.
+func (in *FieldError) DeepCopy() *FieldError {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldError)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *File) DeepCopyInto(out *File) {
 	*out = *in
@@ -2862,12 +3960,120 @@ func (in *FlannelNetworkProvider) DeepCopy() *FlannelNetworkProvider {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GenerateKubeConfigOutput) DeepCopyInto(out *GenerateKubeConfigOutput) {
+func (in *GKECidrBlock) DeepCopyInto(out *GKECidrBlock) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenerateKubeConfigOutput.
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKECidrBlock.
+func (in *GKECidrBlock) DeepCopy() *GKECidrBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(GKECidrBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEMasterAuthorizedNetworks) DeepCopyInto(out *GKEMasterAuthorizedNetworks) {
+	*out = *in
+	if in.CIDRBlocks != nil {
+		in, out := &in.CIDRBlocks, &out.CIDRBlocks
+		*out = make([]GKECidrBlock, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEMasterAuthorizedNetworks.
+func (in *GKEMasterAuthorizedNetworks) DeepCopy() *GKEMasterAuthorizedNetworks {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEMasterAuthorizedNetworks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKENodePool) DeepCopyInto(out *GKENodePool) {
+	*out = *in
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(GKENodePoolAutoscaling)
+			**out = **in
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKENodePool.
+func (in *GKENodePool) DeepCopy() *GKENodePool {
+	if in == nil {
+		return nil
+	}
+	out := new(GKENodePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKENodePoolAutoscaling) DeepCopyInto(out *GKENodePoolAutoscaling) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKENodePoolAutoscaling.
+func (in *GKENodePoolAutoscaling) DeepCopy() *GKENodePoolAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(GKENodePoolAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEPrivateCluster) DeepCopyInto(out *GKEPrivateCluster) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEPrivateCluster.
+func (in *GKEPrivateCluster) DeepCopy() *GKEPrivateCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEPrivateCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GenerateKubeConfigOutput) DeepCopyInto(out *GenerateKubeConfigOutput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenerateKubeConfigOutput.
 func (in *GenerateKubeConfigOutput) DeepCopy() *GenerateKubeConfigOutput {
 	if in == nil {
 		return nil
@@ -2954,6 +4160,22 @@ func (in *GithubConfigTestOutput) DeepCopy() *GithubConfigTestOutput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalAwsOpts) DeepCopyInto(out *GlobalAwsOpts) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalAwsOpts.
+func (in *GlobalAwsOpts) DeepCopy() *GlobalAwsOpts {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalAwsOpts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalComposeConfig) DeepCopyInto(out *GlobalComposeConfig) {
 	*out = *in
@@ -3017,6 +4239,31 @@ func (in *GlobalComposeConfigList) DeepCopyObject() runtime.Object {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalOpenstackOpts) DeepCopyInto(out *GlobalOpenstackOpts) {
+	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SecretKeyRef)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalOpenstackOpts.
+func (in *GlobalOpenstackOpts) DeepCopy() *GlobalOpenstackOpts {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalOpenstackOpts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalRole) DeepCopyInto(out *GlobalRole) {
 	*out = *in
@@ -3146,6 +4393,31 @@ func (in *GlobalRoleList) DeepCopyObject() runtime.Object {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalVsphereOpts) DeepCopyInto(out *GlobalVsphereOpts) {
+	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SecretKeyRef)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalVsphereOpts.
+func (in *GlobalVsphereOpts) DeepCopy() *GlobalVsphereOpts {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalVsphereOpts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GoogleKubernetesEngineConfig) DeepCopyInto(out *GoogleKubernetesEngineConfig) {
 	*out = *in
@@ -3161,6 +4433,38 @@ func (in *GoogleKubernetesEngineConfig) DeepCopyInto(out *GoogleKubernetesEngine
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NodePools != nil {
+		in, out := &in.NodePools, &out.NodePools
+		*out = make([]GKENodePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MasterAuthorizedNetworks != nil {
+		in, out := &in.MasterAuthorizedNetworks, &out.MasterAuthorizedNetworks
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(GKEMasterAuthorizedNetworks)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.PrivateCluster != nil {
+		in, out := &in.PrivateCluster, &out.PrivateCluster
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(GKEPrivateCluster)
+			**out = **in
+		}
+	}
+	if in.ResourceLabels != nil {
+		in, out := &in.ResourceLabels, &out.ResourceLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -3296,6 +4600,38 @@ func (in *GroupMemberList) DeepCopyObject() runtime.Object {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPBasicAuth) DeepCopyInto(out *HTTPBasicAuth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPBasicAuth.
+func (in *HTTPBasicAuth) DeepCopy() *HTTPBasicAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPBasicAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPTLSConfig) DeepCopyInto(out *HTTPTLSConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTLSConfig.
+func (in *HTTPTLSConfig) DeepCopy() *HTTPTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
 	*out = *in
@@ -3397,6 +4733,34 @@ func (in *IngressConfig) DeepCopy() *IngressConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InhibitRule) DeepCopyInto(out *InhibitRule) {
+	*out = *in
+	if in.TargetMatchers != nil {
+		in, out := &in.TargetMatchers, &out.TargetMatchers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Equal != nil {
+		in, out := &in.Equal, &out.Equal
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InhibitRule.
+func (in *InhibitRule) DeepCopy() *InhibitRule {
+	if in == nil {
+		return nil
+	}
+	out := new(InhibitRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KafkaConfig) DeepCopyInto(out *KafkaConfig) {
 	*out = *in
@@ -3418,10 +4782,44 @@ func (in *KafkaConfig) DeepCopy() *KafkaConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyRotation) DeepCopyInto(out *KeyRotation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyRotation.
+func (in *KeyRotation) DeepCopy() *KeyRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeAPIService) DeepCopyInto(out *KubeAPIService) {
 	*out = *in
 	in.BaseService.DeepCopyInto(&out.BaseService)
+	if in.AuditLog != nil {
+		in, out := &in.AuditLog, &out.AuditLog
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(AuditLog)
+			**out = **in
+		}
+	}
+	if in.SecretsEncryptionConfig != nil {
+		in, out := &in.SecretsEncryptionConfig, &out.SecretsEncryptionConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SecretsEncryptionConfig)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -3527,86 +4925,475 @@ func (in *KubernetesServicesOptions) DeepCopyInto(out *KubernetesServicesOptions
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesServicesOptions.
-func (in *KubernetesServicesOptions) DeepCopy() *KubernetesServicesOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesServicesOptions.
+func (in *KubernetesServicesOptions) DeepCopy() *KubernetesServicesOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesServicesOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListOpts) DeepCopyInto(out *ListOpts) {
+	*out = *in
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListOpts.
+func (in *ListOpts) DeepCopy() *ListOpts {
+	if in == nil {
+		return nil
+	}
+	out := new(ListOpts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenConfig) DeepCopyInto(out *ListenConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TOS != nil {
+		in, out := &in.TOS, &out.TOS
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KnownIPs != nil {
+		in, out := &in.KnownIPs, &out.KnownIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GeneratedCerts != nil {
+		in, out := &in.GeneratedCerts, &out.GeneratedCerts
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SubjectAlternativeNames != nil {
+		in, out := &in.SubjectAlternativeNames, &out.SubjectAlternativeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenConfig.
+func (in *ListenConfig) DeepCopy() *ListenConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListenConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenConfigList) DeepCopyInto(out *ListenConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ListenConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenConfigList.
+func (in *ListenConfigList) DeepCopy() *ListenConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListenConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerOpenstackOpts) DeepCopyInto(out *LoadBalancerOpenstackOpts) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerOpenstackOpts.
+func (in *LoadBalancerOpenstackOpts) DeepCopy() *LoadBalancerOpenstackOpts {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerOpenstackOpts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalConfig) DeepCopyInto(out *LocalConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.AuthConfig.DeepCopyInto(&out.AuthConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalConfig.
+func (in *LocalConfig) DeepCopy() *LocalConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocalConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogLevelExtraction) DeepCopyInto(out *LogLevelExtraction) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogLevelExtraction.
+func (in *LogLevelExtraction) DeepCopy() *LogLevelExtraction {
+	if in == nil {
+		return nil
+	}
+	out := new(LogLevelExtraction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingCommonSpec) DeepCopyInto(out *LoggingCommonSpec) {
+	*out = *in
+	if in.OutputTags != nil {
+		in, out := &in.OutputTags, &out.OutputTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ElasticsearchConfig != nil {
+		in, out := &in.ElasticsearchConfig, &out.ElasticsearchConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ElasticsearchConfig)
+			**out = **in
+		}
+	}
+	if in.SplunkConfig != nil {
+		in, out := &in.SplunkConfig, &out.SplunkConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SplunkConfig)
+			**out = **in
+		}
+	}
+	if in.KafkaConfig != nil {
+		in, out := &in.KafkaConfig, &out.KafkaConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(KafkaConfig)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.SyslogConfig != nil {
+		in, out := &in.SyslogConfig, &out.SyslogConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SyslogConfig)
+			**out = **in
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(LoggingFilter)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingCommonSpec.
+func (in *LoggingCommonSpec) DeepCopy() *LoggingCommonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingCommonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingCondition) DeepCopyInto(out *LoggingCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingCondition.
+func (in *LoggingCondition) DeepCopy() *LoggingCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingFilter) DeepCopyInto(out *LoggingFilter) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(LoggingSelector)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(LoggingSelector)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.IncludeLines != nil {
+		in, out := &in.IncludeLines, &out.IncludeLines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeLines != nil {
+		in, out := &in.ExcludeLines, &out.ExcludeLines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LevelExtraction != nil {
+		in, out := &in.LevelExtraction, &out.LevelExtraction
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(LogLevelExtraction)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingFilter.
+func (in *LoggingFilter) DeepCopy() *LoggingFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingSelector) DeepCopyInto(out *LoggingSelector) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkloadSelector != nil {
+		in, out := &in.WorkloadSelector, &out.WorkloadSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingSelector.
+func (in *LoggingSelector) DeepCopy() *LoggingSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingSystemImages) DeepCopyInto(out *LoggingSystemImages) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingSystemImages.
+func (in *LoggingSystemImages) DeepCopy() *LoggingSystemImages {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingSystemImages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSTeamsConfig) DeepCopyInto(out *MSTeamsConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSTeamsConfig.
+func (in *MSTeamsConfig) DeepCopy() *MSTeamsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MSTeamsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageTemplatePreviewInput) DeepCopyInto(out *MessageTemplatePreviewInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageTemplatePreviewInput.
+func (in *MessageTemplatePreviewInput) DeepCopy() *MessageTemplatePreviewInput {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageTemplatePreviewInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageTemplatePreviewOutput) DeepCopyInto(out *MessageTemplatePreviewOutput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageTemplatePreviewOutput.
+func (in *MessageTemplatePreviewOutput) DeepCopy() *MessageTemplatePreviewOutput {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesServicesOptions)
+	out := new(MessageTemplatePreviewOutput)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ListOpts) DeepCopyInto(out *ListOpts) {
+func (in *MetadataOpenstackOpts) DeepCopyInto(out *MetadataOpenstackOpts) {
 	*out = *in
-	if in.Filters != nil {
-		in, out := &in.Filters, &out.Filters
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataOpenstackOpts.
+func (in *MetadataOpenstackOpts) DeepCopy() *MetadataOpenstackOpts {
+	if in == nil {
+		return nil
 	}
+	out := new(MetadataOpenstackOpts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricRule) DeepCopyInto(out *MetricRule) {
+	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListOpts.
-func (in *ListOpts) DeepCopy() *ListOpts {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricRule.
+func (in *MetricRule) DeepCopy() *MetricRule {
 	if in == nil {
 		return nil
 	}
-	out := new(ListOpts)
+	out := new(MetricRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ListenConfig) DeepCopyInto(out *ListenConfig) {
+func (in *MonitorMetric) DeepCopyInto(out *MonitorMetric) {
 	*out = *in
+	out.Namespaced = in.Namespaced
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	if in.Domains != nil {
-		in, out := &in.Domains, &out.Domains
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.TOS != nil {
-		in, out := &in.TOS, &out.TOS
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.KnownIPs != nil {
-		in, out := &in.KnownIPs, &out.KnownIPs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.GeneratedCerts != nil {
-		in, out := &in.GeneratedCerts, &out.GeneratedCerts
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.SubjectAlternativeNames != nil {
-		in, out := &in.SubjectAlternativeNames, &out.SubjectAlternativeNames
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	out.Spec = in.Spec
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenConfig.
-func (in *ListenConfig) DeepCopy() *ListenConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorMetric.
+func (in *MonitorMetric) DeepCopy() *MonitorMetric {
 	if in == nil {
 		return nil
 	}
-	out := new(ListenConfig)
+	out := new(MonitorMetric)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ListenConfig) DeepCopyObject() runtime.Object {
+func (in *MonitorMetric) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	} else {
@@ -3615,13 +5402,13 @@ func (in *ListenConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ListenConfigList) DeepCopyInto(out *ListenConfigList) {
+func (in *MonitorMetricList) DeepCopyInto(out *MonitorMetricList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ListenConfig, len(*in))
+		*out = make([]MonitorMetric, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -3629,18 +5416,18 @@ func (in *ListenConfigList) DeepCopyInto(out *ListenConfigList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenConfigList.
-func (in *ListenConfigList) DeepCopy() *ListenConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorMetricList.
+func (in *MonitorMetricList) DeepCopy() *MonitorMetricList {
 	if in == nil {
 		return nil
 	}
-	out := new(ListenConfigList)
+	out := new(MonitorMetricList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ListenConfigList) DeepCopyObject() runtime.Object {
+func (in *MonitorMetricList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	} else {
@@ -3649,120 +5436,82 @@ func (in *ListenConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalConfig) DeepCopyInto(out *LocalConfig) {
+func (in *MonitorMetricSpec) DeepCopyInto(out *MonitorMetricSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.AuthConfig.DeepCopyInto(&out.AuthConfig)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalConfig.
-func (in *LocalConfig) DeepCopy() *LocalConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorMetricSpec.
+func (in *MonitorMetricSpec) DeepCopy() *MonitorMetricSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalConfig)
+	out := new(MonitorMetricSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LocalConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	} else {
-		return nil
-	}
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoggingCommonSpec) DeepCopyInto(out *LoggingCommonSpec) {
+func (in *MonitoringConfig) DeepCopyInto(out *MonitoringConfig) {
 	*out = *in
-	if in.OutputTags != nil {
-		in, out := &in.OutputTags, &out.OutputTags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.ElasticsearchConfig != nil {
-		in, out := &in.ElasticsearchConfig, &out.ElasticsearchConfig
-		if *in == nil {
-			*out = nil
-		} else {
-			*out = new(ElasticsearchConfig)
-			**out = **in
-		}
-	}
-	if in.SplunkConfig != nil {
-		in, out := &in.SplunkConfig, &out.SplunkConfig
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
 		if *in == nil {
 			*out = nil
 		} else {
-			*out = new(SplunkConfig)
+			*out = new(ContainerResourceLimit)
 			**out = **in
 		}
 	}
-	if in.KafkaConfig != nil {
-		in, out := &in.KafkaConfig, &out.KafkaConfig
-		if *in == nil {
-			*out = nil
-		} else {
-			*out = new(KafkaConfig)
-			(*in).DeepCopyInto(*out)
-		}
-	}
-	if in.SyslogConfig != nil {
-		in, out := &in.SyslogConfig, &out.SyslogConfig
-		if *in == nil {
-			*out = nil
-		} else {
-			*out = new(SyslogConfig)
-			**out = **in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingCommonSpec.
-func (in *LoggingCommonSpec) DeepCopy() *LoggingCommonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringConfig.
+func (in *MonitoringConfig) DeepCopy() *MonitoringConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(LoggingCommonSpec)
+	out := new(MonitoringConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoggingCondition) DeepCopyInto(out *LoggingCondition) {
+func (in *MonitoringSystemImages) DeepCopyInto(out *MonitoringSystemImages) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingCondition.
-func (in *LoggingCondition) DeepCopy() *LoggingCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringSystemImages.
+func (in *MonitoringSystemImages) DeepCopy() *MonitoringSystemImages {
 	if in == nil {
 		return nil
 	}
-	out := new(LoggingCondition)
+	out := new(MonitoringSystemImages)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoggingSystemImages) DeepCopyInto(out *LoggingSystemImages) {
+func (in *NamespaceResourceQuota) DeepCopyInto(out *NamespaceResourceQuota) {
 	*out = *in
+	out.Limit = in.Limit
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingSystemImages.
-func (in *LoggingSystemImages) DeepCopy() *LoggingSystemImages {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceResourceQuota.
+func (in *NamespaceResourceQuota) DeepCopy() *NamespaceResourceQuota {
 	if in == nil {
 		return nil
 	}
-	out := new(LoggingSystemImages)
+	out := new(NamespaceResourceQuota)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3817,6 +5566,22 @@ func (in *NetworkConfig) DeepCopy() *NetworkConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkVsphereOpts) DeepCopyInto(out *NetworkVsphereOpts) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkVsphereOpts.
+func (in *NetworkVsphereOpts) DeepCopy() *NetworkVsphereOpts {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkVsphereOpts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Node) DeepCopyInto(out *Node) {
 	*out = *in
@@ -3910,6 +5675,22 @@ func (in *NodeCondition) DeepCopy() *NodeCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeDrainInput) DeepCopyInto(out *NodeDrainInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeDrainInput.
+func (in *NodeDrainInput) DeepCopy() *NodeDrainInput {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDrainInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeDriver) DeepCopyInto(out *NodeDriver) {
 	*out = *in
@@ -4049,6 +5830,44 @@ func (in *NodeList) DeepCopyObject() runtime.Object {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePlanChange) DeepCopyInto(out *NodePlanChange) {
+	*out = *in
+	if in.Processes != nil {
+		in, out := &in.Processes, &out.Processes
+		*out = make([]ProcessChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AddedFiles != nil {
+		in, out := &in.AddedFiles, &out.AddedFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ChangedFiles != nil {
+		in, out := &in.ChangedFiles, &out.ChangedFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemovedFiles != nil {
+		in, out := &in.RemovedFiles, &out.RemovedFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePlanChange.
+func (in *NodePlanChange) DeepCopy() *NodePlanChange {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePlanChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodePool) DeepCopyInto(out *NodePool) {
 	*out = *in
@@ -4130,6 +5949,13 @@ func (in *NodePoolSpec) DeepCopyInto(out *NodePoolSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.NodeTaints != nil {
+		in, out := &in.NodeTaints, &out.NodeTaints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4191,6 +6017,13 @@ func (in *NodeSpec) DeepCopyInto(out *NodeSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DesiredNodeTaints != nil {
+		in, out := &in.DesiredNodeTaints, &out.DesiredNodeTaints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4266,6 +6099,13 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AppliedNodeTaints != nil {
+		in, out := &in.AppliedNodeTaints, &out.AppliedNodeTaints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DockerInfo != nil {
 		in, out := &in.DockerInfo, &out.DockerInfo
 		if *in == nil {
@@ -4354,54 +6194,105 @@ func (in *NodeTemplateList) DeepCopy() *NodeTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeTemplateList)
+	out := new(NodeTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTemplateSpec) DeepCopyInto(out *NodeTemplateSpec) {
+	*out = *in
+	in.NodeCommonParams.DeepCopyInto(&out.NodeCommonParams)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTemplateSpec.
+func (in *NodeTemplateSpec) DeepCopy() *NodeTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTemplateStatus) DeepCopyInto(out *NodeTemplateStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]NodeTemplateCondition, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTemplateStatus.
+func (in *NodeTemplateStatus) DeepCopy() *NodeTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTemplateStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NodeTemplateList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	} else {
-		return nil
-	}
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeTemplateSpec) DeepCopyInto(out *NodeTemplateSpec) {
+func (in *NodeUpgradeProgress) DeepCopyInto(out *NodeUpgradeProgress) {
 	*out = *in
-	in.NodeCommonParams.DeepCopyInto(&out.NodeCommonParams)
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTemplateSpec.
-func (in *NodeTemplateSpec) DeepCopy() *NodeTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeUpgradeProgress.
+func (in *NodeUpgradeProgress) DeepCopy() *NodeUpgradeProgress {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeTemplateSpec)
+	out := new(NodeUpgradeProgress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeTemplateStatus) DeepCopyInto(out *NodeTemplateStatus) {
+func (in *NodeUpgradeStrategy) DeepCopyInto(out *NodeUpgradeStrategy) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]NodeTemplateCondition, len(*in))
+	if in.DrainInput != nil {
+		in, out := &in.DrainInput, &out.DrainInput
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(NodeDrainInput)
+			**out = **in
+		}
+	}
+	if in.RoleOrder != nil {
+		in, out := &in.RoleOrder, &out.RoleOrder
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTemplateStatus.
-func (in *NodeTemplateStatus) DeepCopy() *NodeTemplateStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeUpgradeStrategy.
+func (in *NodeUpgradeStrategy) DeepCopy() *NodeUpgradeStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeTemplateStatus)
+	out := new(NodeUpgradeStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4442,6 +6333,24 @@ func (in *Notification) DeepCopyInto(out *Notification) {
 			*out = nil
 		} else {
 			*out = new(WebhookConfig)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.OpsgenieConfig != nil {
+		in, out := &in.OpsgenieConfig, &out.OpsgenieConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(OpsgenieConfig)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.MSTeamsConfig != nil {
+		in, out := &in.MSTeamsConfig, &out.MSTeamsConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MSTeamsConfig)
 			**out = **in
 		}
 	}
@@ -4465,7 +6374,7 @@ func (in *Notifier) DeepCopyInto(out *Notifier) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -4522,6 +6431,22 @@ func (in *NotifierList) DeepCopyObject() runtime.Object {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifierSendAttempt) DeepCopyInto(out *NotifierSendAttempt) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifierSendAttempt.
+func (in *NotifierSendAttempt) DeepCopy() *NotifierSendAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifierSendAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NotifierSpec) DeepCopyInto(out *NotifierSpec) {
 	*out = *in
@@ -4558,6 +6483,24 @@ func (in *NotifierSpec) DeepCopyInto(out *NotifierSpec) {
 			*out = nil
 		} else {
 			*out = new(WebhookConfig)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.OpsgenieConfig != nil {
+		in, out := &in.OpsgenieConfig, &out.OpsgenieConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(OpsgenieConfig)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.MSTeamsConfig != nil {
+		in, out := &in.MSTeamsConfig, &out.MSTeamsConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MSTeamsConfig)
 			**out = **in
 		}
 	}
@@ -4577,6 +6520,25 @@ func (in *NotifierSpec) DeepCopy() *NotifierSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NotifierStatus) DeepCopyInto(out *NotifierStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastSend != nil {
+		in, out := &in.LastSend, &out.LastSend
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(NotifierSendAttempt)
+			**out = **in
+		}
+	}
+	if in.SendHistory != nil {
+		in, out := &in.SendHistory, &out.SendHistory
+		*out = make([]NotifierSendAttempt, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4590,6 +6552,48 @@ func (in *NotifierStatus) DeepCopy() *NotifierStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenstackCloudProvider) DeepCopyInto(out *OpenstackCloudProvider) {
+	*out = *in
+	in.Global.DeepCopyInto(&out.Global)
+	out.LoadBalancer = in.LoadBalancer
+	out.BlockStorage = in.BlockStorage
+	out.Route = in.Route
+	out.Metadata = in.Metadata
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenstackCloudProvider.
+func (in *OpenstackCloudProvider) DeepCopy() *OpenstackCloudProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenstackCloudProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsgenieConfig) DeepCopyInto(out *OpsgenieConfig) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsgenieConfig.
+func (in *OpsgenieConfig) DeepCopy() *OpsgenieConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsgenieConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PagerdutyConfig) DeepCopyInto(out *PagerdutyConfig) {
 	*out = *in
@@ -5225,6 +7229,15 @@ func (in *PrincipalList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateRegistry) DeepCopyInto(out *PrivateRegistry) {
 	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SecretKeyRef)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -5287,13 +7300,62 @@ func (in *Process) DeepCopy() *Process {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessChange) DeepCopyInto(out *ProcessChange) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]ProcessFieldChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessChange.
+func (in *ProcessChange) DeepCopy() *ProcessChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessFieldChange) DeepCopyInto(out *ProcessFieldChange) {
+	*out = *in
+	if in.Added != nil {
+		in, out := &in.Added, &out.Added
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Removed != nil {
+		in, out := &in.Removed, &out.Removed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessFieldChange.
+func (in *ProcessFieldChange) DeepCopy() *ProcessFieldChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessFieldChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
 	out.Namespaced = in.Namespaced
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -5324,7 +7386,7 @@ func (in *ProjectAlert) DeepCopyInto(out *ProjectAlert) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -5347,6 +7409,86 @@ func (in *ProjectAlert) DeepCopyObject() runtime.Object {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAlertGroup) DeepCopyInto(out *ProjectAlertGroup) {
+	*out = *in
+	out.Namespaced = in.Namespaced
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAlertGroup.
+func (in *ProjectAlertGroup) DeepCopy() *ProjectAlertGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAlertGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectAlertGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAlertGroupList) DeepCopyInto(out *ProjectAlertGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectAlertGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAlertGroupList.
+func (in *ProjectAlertGroupList) DeepCopy() *ProjectAlertGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAlertGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectAlertGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAlertGroupSpec) DeepCopyInto(out *ProjectAlertGroupSpec) {
+	*out = *in
+	in.AlertGroupSpec.DeepCopyInto(&out.AlertGroupSpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAlertGroupSpec.
+func (in *ProjectAlertGroupSpec) DeepCopy() *ProjectAlertGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAlertGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectAlertList) DeepCopyInto(out *ProjectAlertList) {
 	*out = *in
@@ -5403,6 +7545,15 @@ func (in *ProjectAlertSpec) DeepCopyInto(out *ProjectAlertSpec) {
 			**out = **in
 		}
 	}
+	if in.TargetMetric != nil {
+		in, out := &in.TargetMetric, &out.TargetMetric
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MetricRule)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -5663,12 +7814,47 @@ func (in *ProjectNetworkPolicyStatus) DeepCopyInto(out *ProjectNetworkPolicyStat
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNetworkPolicyStatus.
-func (in *ProjectNetworkPolicyStatus) DeepCopy() *ProjectNetworkPolicyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNetworkPolicyStatus.
+func (in *ProjectNetworkPolicyStatus) DeepCopy() *ProjectNetworkPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectNetworkPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectResourceQuota) DeepCopyInto(out *ProjectResourceQuota) {
+	*out = *in
+	out.Limit = in.Limit
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectResourceQuota.
+func (in *ProjectResourceQuota) DeepCopy() *ProjectResourceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectResourceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectResourceQuotaUsage) DeepCopyInto(out *ProjectResourceQuotaUsage) {
+	*out = *in
+	out.Limit = in.Limit
+	out.UsedLimit = in.UsedLimit
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectResourceQuotaUsage.
+func (in *ProjectResourceQuotaUsage) DeepCopy() *ProjectResourceQuotaUsage {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectNetworkPolicyStatus)
+	out := new(ProjectResourceQuotaUsage)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -5738,6 +7924,42 @@ func (in *ProjectRoleTemplateBindingList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ProjectResourceQuota)
+			**out = **in
+		}
+	}
+	if in.NamespaceDefaultResourceQuota != nil {
+		in, out := &in.NamespaceDefaultResourceQuota, &out.NamespaceDefaultResourceQuota
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(NamespaceResourceQuota)
+			**out = **in
+		}
+	}
+	if in.ContainerDefaultResourceLimit != nil {
+		in, out := &in.ContainerDefaultResourceLimit, &out.ContainerDefaultResourceLimit
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ContainerResourceLimit)
+			**out = **in
+		}
+	}
+	if in.MonitoringConfig != nil {
+		in, out := &in.MonitoringConfig, &out.MonitoringConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MonitoringConfig)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -5759,6 +7981,15 @@ func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 		*out = make([]ProjectCondition, len(*in))
 		copy(*out, *in)
 	}
+	if in.ResourceQuotaUsage != nil {
+		in, out := &in.ResourceQuotaUsage, &out.ResourceQuotaUsage
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ProjectResourceQuotaUsage)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -5845,6 +8076,15 @@ func (in *RKEConfigNode) DeepCopyInto(out *RKEConfigNode) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SSHKeySecretRef != nil {
+		in, out := &in.SSHKeySecretRef, &out.SSHKeySecretRef
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SecretKeyRef)
+			**out = **in
+		}
+	}
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
 		*out = make(map[string]string, len(*in))
@@ -5852,6 +8092,13 @@ func (in *RKEConfigNode) DeepCopyInto(out *RKEConfigNode) {
 			(*out)[key] = val
 		}
 	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -5899,6 +8146,13 @@ func (in *RKEConfigNodePlan) DeepCopyInto(out *RKEConfigNodePlan) {
 			(*out)[key] = val
 		}
 	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -5996,11 +8250,31 @@ func (in *RancherKubernetesEngineConfig) DeepCopyInto(out *RancherKubernetesEngi
 	if in.PrivateRegistries != nil {
 		in, out := &in.PrivateRegistries, &out.PrivateRegistries
 		*out = make([]PrivateRegistry, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	in.Ingress.DeepCopyInto(&out.Ingress)
 	in.CloudProvider.DeepCopyInto(&out.CloudProvider)
-	out.BastionHost = in.BastionHost
+	in.BastionHost.DeepCopyInto(&out.BastionHost)
+	if in.UpgradeStrategy != nil {
+		in, out := &in.UpgradeStrategy, &out.UpgradeStrategy
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(NodeUpgradeStrategy)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(DNSConfig)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -6046,6 +8320,38 @@ func (in *RepoPerm) DeepCopy() *RepoPerm {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceQuotaLimit) DeepCopyInto(out *ResourceQuotaLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaLimit.
+func (in *ResourceQuotaLimit) DeepCopy() *ResourceQuotaLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceQuotaLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreFromEtcdBackupInput) DeepCopyInto(out *RestoreFromEtcdBackupInput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFromEtcdBackupInput.
+func (in *RestoreFromEtcdBackupInput) DeepCopy() *RestoreFromEtcdBackupInput {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreFromEtcdBackupInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleTemplate) DeepCopyInto(out *RoleTemplate) {
 	*out = *in
@@ -6128,6 +8434,22 @@ func (in *RoleTemplateList) DeepCopyObject() runtime.Object {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteOpenstackOpts) DeepCopyInto(out *RouteOpenstackOpts) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteOpenstackOpts.
+func (in *RouteOpenstackOpts) DeepCopy() *RouteOpenstackOpts {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteOpenstackOpts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RunPipelineInput) DeepCopyInto(out *RunPipelineInput) {
 	*out = *in
@@ -6165,6 +8487,31 @@ func (in *RunScriptConfig) DeepCopy() *RunScriptConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3BackupConfig) DeepCopyInto(out *S3BackupConfig) {
+	*out = *in
+	if in.CredentialSecret != nil {
+		in, out := &in.CredentialSecret, &out.CredentialSecret
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.SecretReference)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3BackupConfig.
+func (in *S3BackupConfig) DeepCopy() *S3BackupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(S3BackupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SMTPConfig) DeepCopyInto(out *SMTPConfig) {
 	*out = *in
@@ -6214,6 +8561,70 @@ func (in *SearchPrincipalsInput) DeepCopy() *SearchPrincipalsInput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyRef.
+func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretsEncryptionConfig) DeepCopyInto(out *SecretsEncryptionConfig) {
+	*out = *in
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]EncryptionProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KeyRotation != nil {
+		in, out := &in.KeyRotation, &out.KeyRotation
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(KeyRotation)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsEncryptionConfig.
+func (in *SecretsEncryptionConfig) DeepCopy() *SecretsEncryptionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretsEncryptionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceOverride) DeepCopyInto(out *ServiceOverride) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOverride.
+func (in *ServiceOverride) DeepCopy() *ServiceOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SetPasswordInput) DeepCopyInto(out *SetPasswordInput) {
 	*out = *in
@@ -6307,6 +8718,22 @@ func (in *SettingList) DeepCopyObject() runtime.Object {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SilenceWindow) DeepCopyInto(out *SilenceWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceWindow.
+func (in *SilenceWindow) DeepCopy() *SilenceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(SilenceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SlackConfig) DeepCopyInto(out *SlackConfig) {
 	*out = *in
@@ -7208,6 +9635,32 @@ func (in *UserList) DeepCopyObject() runtime.Object {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidationResult) DeepCopyInto(out *ValidationResult) {
+	*out = *in
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]FieldError, len(*in))
+		copy(*out, *in)
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]FieldError, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidationResult.
+func (in *ValidationResult) DeepCopy() *ValidationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Values) DeepCopyInto(out *Values) {
 	*out = *in
@@ -7252,9 +9705,86 @@ func (in *VersionCommits) DeepCopy() *VersionCommits {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualCenterConfig) DeepCopyInto(out *VirtualCenterConfig) {
+	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SecretKeyRef)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualCenterConfig.
+func (in *VirtualCenterConfig) DeepCopy() *VirtualCenterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualCenterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VsphereCloudProvider) DeepCopyInto(out *VsphereCloudProvider) {
+	*out = *in
+	in.Global.DeepCopyInto(&out.Global)
+	if in.VirtualCenter != nil {
+		in, out := &in.VirtualCenter, &out.VirtualCenter
+		*out = make(map[string]VirtualCenterConfig, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	out.Network = in.Network
+	out.Disk = in.Disk
+	out.Workspace = in.Workspace
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VsphereCloudProvider.
+func (in *VsphereCloudProvider) DeepCopy() *VsphereCloudProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(VsphereCloudProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookConfig) DeepCopyInto(out *WebhookConfig) {
 	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(HTTPBasicAuth)
+			**out = **in
+		}
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(HTTPTLSConfig)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -7267,3 +9797,19 @@ func (in *WebhookConfig) DeepCopy() *WebhookConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceVsphereOpts) DeepCopyInto(out *WorkspaceVsphereOpts) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceVsphereOpts.
+func (in *WorkspaceVsphereOpts) DeepCopy() *WorkspaceVsphereOpts {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceVsphereOpts)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -30,6 +30,9 @@ type Interface interface {
 	ClustersGetter
 	ClusterEventsGetter
 	ClusterRegistrationTokensGetter
+	ClusterBackupsGetter
+	ClusterTemplatesGetter
+	ClusterTemplateRevisionsGetter
 	CatalogsGetter
 	TemplatesGetter
 	TemplateVersionsGetter
@@ -50,6 +53,9 @@ type Interface interface {
 	NotifiersGetter
 	ClusterAlertsGetter
 	ProjectAlertsGetter
+	ClusterAlertGroupsGetter
+	ProjectAlertGroupsGetter
+	MonitorMetricsGetter
 	ClusterPipelinesGetter
 	SourceCodeCredentialsGetter
 	PipelinesGetter
@@ -80,6 +86,9 @@ type Client struct {
 	clusterControllers                                 map[string]ClusterController
 	clusterEventControllers                            map[string]ClusterEventController
 	clusterRegistrationTokenControllers                map[string]ClusterRegistrationTokenController
+	clusterBackupControllers                           map[string]ClusterBackupController
+	clusterTemplateControllers                         map[string]ClusterTemplateController
+	clusterTemplateRevisionControllers                 map[string]ClusterTemplateRevisionController
 	catalogControllers                                 map[string]CatalogController
 	templateControllers                                map[string]TemplateController
 	templateVersionControllers                         map[string]TemplateVersionController
@@ -100,6 +109,9 @@ type Client struct {
 	notifierControllers                                map[string]NotifierController
 	clusterAlertControllers                            map[string]ClusterAlertController
 	projectAlertControllers                            map[string]ProjectAlertController
+	clusterAlertGroupControllers                       map[string]ClusterAlertGroupController
+	projectAlertGroupControllers                       map[string]ProjectAlertGroupController
+	monitorMetricControllers                           map[string]MonitorMetricController
 	clusterPipelineControllers                         map[string]ClusterPipelineController
 	sourceCodeCredentialControllers                    map[string]SourceCodeCredentialController
 	pipelineControllers                                map[string]PipelineController
@@ -139,6 +151,9 @@ func NewForConfig(config rest.Config) (Interface, error) {
 		clusterControllers:                                 map[string]ClusterController{},
 		clusterEventControllers:                            map[string]ClusterEventController{},
 		clusterRegistrationTokenControllers:                map[string]ClusterRegistrationTokenController{},
+		clusterBackupControllers:                           map[string]ClusterBackupController{},
+		clusterTemplateControllers:                         map[string]ClusterTemplateController{},
+		clusterTemplateRevisionControllers:                 map[string]ClusterTemplateRevisionController{},
 		catalogControllers:                                 map[string]CatalogController{},
 		templateControllers:                                map[string]TemplateController{},
 		templateVersionControllers:                         map[string]TemplateVersionController{},
@@ -159,6 +174,9 @@ func NewForConfig(config rest.Config) (Interface, error) {
 		notifierControllers:                                map[string]NotifierController{},
 		clusterAlertControllers:                            map[string]ClusterAlertController{},
 		projectAlertControllers:                            map[string]ProjectAlertController{},
+		clusterAlertGroupControllers:                       map[string]ClusterAlertGroupController{},
+		projectAlertGroupControllers:                       map[string]ProjectAlertGroupController{},
+		monitorMetricControllers:                           map[string]MonitorMetricController{},
 		clusterPipelineControllers:                         map[string]ClusterPipelineController{},
 		sourceCodeCredentialControllers:                    map[string]SourceCodeCredentialController{},
 		pipelineControllers:                                map[string]PipelineController{},
@@ -377,6 +395,45 @@ func (c *Client) ClusterRegistrationTokens(namespace string) ClusterRegistration
 	}
 }
 
+type ClusterBackupsGetter interface {
+	ClusterBackups(namespace string) ClusterBackupInterface
+}
+
+func (c *Client) ClusterBackups(namespace string) ClusterBackupInterface {
+	objectClient := objectclient.NewObjectClient(namespace, c.restClient, &ClusterBackupResource, ClusterBackupGroupVersionKind, clusterBackupFactory{})
+	return &clusterBackupClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
+type ClusterTemplatesGetter interface {
+	ClusterTemplates(namespace string) ClusterTemplateInterface
+}
+
+func (c *Client) ClusterTemplates(namespace string) ClusterTemplateInterface {
+	objectClient := objectclient.NewObjectClient(namespace, c.restClient, &ClusterTemplateResource, ClusterTemplateGroupVersionKind, clusterTemplateFactory{})
+	return &clusterTemplateClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
+type ClusterTemplateRevisionsGetter interface {
+	ClusterTemplateRevisions(namespace string) ClusterTemplateRevisionInterface
+}
+
+func (c *Client) ClusterTemplateRevisions(namespace string) ClusterTemplateRevisionInterface {
+	objectClient := objectclient.NewObjectClient(namespace, c.restClient, &ClusterTemplateRevisionResource, ClusterTemplateRevisionGroupVersionKind, clusterTemplateRevisionFactory{})
+	return &clusterTemplateRevisionClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
 type CatalogsGetter interface {
 	Catalogs(namespace string) CatalogInterface
 }
@@ -637,6 +694,45 @@ func (c *Client) ProjectAlerts(namespace string) ProjectAlertInterface {
 	}
 }
 
+type ClusterAlertGroupsGetter interface {
+	ClusterAlertGroups(namespace string) ClusterAlertGroupInterface
+}
+
+func (c *Client) ClusterAlertGroups(namespace string) ClusterAlertGroupInterface {
+	objectClient := objectclient.NewObjectClient(namespace, c.restClient, &ClusterAlertGroupResource, ClusterAlertGroupGroupVersionKind, clusterAlertGroupFactory{})
+	return &clusterAlertGroupClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
+type ProjectAlertGroupsGetter interface {
+	ProjectAlertGroups(namespace string) ProjectAlertGroupInterface
+}
+
+func (c *Client) ProjectAlertGroups(namespace string) ProjectAlertGroupInterface {
+	objectClient := objectclient.NewObjectClient(namespace, c.restClient, &ProjectAlertGroupResource, ProjectAlertGroupGroupVersionKind, projectAlertGroupFactory{})
+	return &projectAlertGroupClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
+type MonitorMetricsGetter interface {
+	MonitorMetrics(namespace string) MonitorMetricInterface
+}
+
+func (c *Client) MonitorMetrics(namespace string) MonitorMetricInterface {
+	objectClient := objectclient.NewObjectClient(namespace, c.restClient, &MonitorMetricResource, MonitorMetricGroupVersionKind, monitorMetricFactory{})
+	return &monitorMetricClient{
+		ns:           namespace,
+		client:       c,
+		objectClient: objectClient,
+	}
+}
+
 type ClusterPipelinesGetter interface {
 	ClusterPipelines(namespace string) ClusterPipelineInterface
 }
@@ -63,6 +63,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterEventList{},
 		&ClusterRegistrationToken{},
 		&ClusterRegistrationTokenList{},
+		&ClusterBackup{},
+		&ClusterBackupList{},
+		&ClusterTemplate{},
+		&ClusterTemplateList{},
+		&ClusterTemplateRevision{},
+		&ClusterTemplateRevisionList{},
 		&Catalog{},
 		&CatalogList{},
 		&Template{},
@@ -103,6 +109,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterAlertList{},
 		&ProjectAlert{},
 		&ProjectAlertList{},
+		&ClusterAlertGroup{},
+		&ClusterAlertGroupList{},
+		&ProjectAlertGroup{},
+		&ProjectAlertGroupList{},
+		&MonitorMetric{},
+		&MonitorMetricList{},
 		&ClusterPipeline{},
 		&ClusterPipelineList{},
 		&SourceCodeCredential{},
@@ -0,0 +1,18 @@
+package client
+
+const (
+	AKSAgentPoolType              = "aksAgentPool"
+	AKSAgentPoolFieldCount        = "count"
+	AKSAgentPoolFieldMaxPods      = "maxPods"
+	AKSAgentPoolFieldName         = "name"
+	AKSAgentPoolFieldOsDiskSizeGB = "osDiskSizeGb"
+	AKSAgentPoolFieldVMSize       = "vmSize"
+)
+
+type AKSAgentPool struct {
+	Count        int64  `json:"count,omitempty" yaml:"count,omitempty"`
+	MaxPods      int64  `json:"maxPods,omitempty" yaml:"maxPods,omitempty"`
+	Name         string `json:"name,omitempty" yaml:"name,omitempty"`
+	OsDiskSizeGB int64  `json:"osDiskSizeGb,omitempty" yaml:"osDiskSizeGb,omitempty"`
+	VMSize       string `json:"vmSize,omitempty" yaml:"vmSize,omitempty"`
+}
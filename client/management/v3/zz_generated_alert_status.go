@@ -1,10 +1,12 @@
 package client
 
 const (
-	AlertStatusType            = "alertStatus"
-	AlertStatusFieldAlertState = "alertState"
+	AlertStatusType             = "alertStatus"
+	AlertStatusFieldAlertState  = "alertState"
+	AlertStatusFieldSuppression = "suppression"
 )
 
 type AlertStatus struct {
-	AlertState string `json:"alertState,omitempty" yaml:"alertState,omitempty"`
+	AlertState  string            `json:"alertState,omitempty" yaml:"alertState,omitempty"`
+	Suppression *AlertSuppression `json:"suppression,omitempty" yaml:"suppression,omitempty"`
 }
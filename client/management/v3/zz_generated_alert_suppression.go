@@ -0,0 +1,14 @@
+package client
+
+const (
+	AlertSuppressionType         = "alertSuppression"
+	AlertSuppressionFieldMessage = "message"
+	AlertSuppressionFieldReason  = "reason"
+	AlertSuppressionFieldUntil   = "until"
+)
+
+type AlertSuppression struct {
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+	Reason  string `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Until   string `json:"until,omitempty" yaml:"until,omitempty"`
+}
@@ -1,12 +1,36 @@
 package client
 
 const (
-	AmazonElasticContainerServiceConfigType           = "amazonElasticContainerServiceConfig"
-	AmazonElasticContainerServiceConfigFieldAccessKey = "accessKey"
-	AmazonElasticContainerServiceConfigFieldSecretKey = "secretKey"
+	AmazonElasticContainerServiceConfigType                   = "amazonElasticContainerServiceConfig"
+	AmazonElasticContainerServiceConfigFieldAMI               = "ami"
+	AmazonElasticContainerServiceConfigFieldAccessKey         = "accessKey"
+	AmazonElasticContainerServiceConfigFieldDesiredNodes      = "desiredNodes"
+	AmazonElasticContainerServiceConfigFieldInstanceType      = "instanceType"
+	AmazonElasticContainerServiceConfigFieldKeyPairName       = "keyPairName"
+	AmazonElasticContainerServiceConfigFieldKubernetesVersion = "kubernetesVersion"
+	AmazonElasticContainerServiceConfigFieldMaximumNodes      = "maximumNodes"
+	AmazonElasticContainerServiceConfigFieldMinimumNodes      = "minimumNodes"
+	AmazonElasticContainerServiceConfigFieldRegion            = "region"
+	AmazonElasticContainerServiceConfigFieldSecretKey         = "secretKey"
+	AmazonElasticContainerServiceConfigFieldSecurityGroups    = "securityGroups"
+	AmazonElasticContainerServiceConfigFieldServiceRole       = "serviceRole"
+	AmazonElasticContainerServiceConfigFieldSubnets           = "subnets"
+	AmazonElasticContainerServiceConfigFieldVirtualNetwork    = "virtualNetwork"
 )
 
 type AmazonElasticContainerServiceConfig struct {
-	AccessKey string `json:"accessKey,omitempty" yaml:"accessKey,omitempty"`
-	SecretKey string `json:"secretKey,omitempty" yaml:"secretKey,omitempty"`
+	AMI               string   `json:"ami,omitempty" yaml:"ami,omitempty"`
+	AccessKey         string   `json:"accessKey,omitempty" yaml:"accessKey,omitempty"`
+	DesiredNodes      int64    `json:"desiredNodes,omitempty" yaml:"desiredNodes,omitempty"`
+	InstanceType      string   `json:"instanceType,omitempty" yaml:"instanceType,omitempty"`
+	KeyPairName       string   `json:"keyPairName,omitempty" yaml:"keyPairName,omitempty"`
+	KubernetesVersion string   `json:"kubernetesVersion,omitempty" yaml:"kubernetesVersion,omitempty"`
+	MaximumNodes      int64    `json:"maximumNodes,omitempty" yaml:"maximumNodes,omitempty"`
+	MinimumNodes      int64    `json:"minimumNodes,omitempty" yaml:"minimumNodes,omitempty"`
+	Region            string   `json:"region,omitempty" yaml:"region,omitempty"`
+	SecretKey         string   `json:"secretKey,omitempty" yaml:"secretKey,omitempty"`
+	SecurityGroups    []string `json:"securityGroups,omitempty" yaml:"securityGroups,omitempty"`
+	ServiceRole       string   `json:"serviceRole,omitempty" yaml:"serviceRole,omitempty"`
+	Subnets           []string `json:"subnets,omitempty" yaml:"subnets,omitempty"`
+	VirtualNetwork    string   `json:"virtualNetwork,omitempty" yaml:"virtualNetwork,omitempty"`
 }
@@ -0,0 +1,22 @@
+package client
+
+const (
+	AuditLogType           = "auditLog"
+	AuditLogFieldEnabled   = "enabled"
+	AuditLogFieldFormat    = "format"
+	AuditLogFieldMaxAge    = "maxAge"
+	AuditLogFieldMaxBackup = "maxBackup"
+	AuditLogFieldMaxSize   = "maxSize"
+	AuditLogFieldPath      = "path"
+	AuditLogFieldPolicy    = "policy"
+)
+
+type AuditLog struct {
+	Enabled   bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Format    string `json:"format,omitempty" yaml:"format,omitempty"`
+	MaxAge    int64  `json:"maxAge,omitempty" yaml:"maxAge,omitempty"`
+	MaxBackup int64  `json:"maxBackup,omitempty" yaml:"maxBackup,omitempty"`
+	MaxSize   int64  `json:"maxSize,omitempty" yaml:"maxSize,omitempty"`
+	Path      string `json:"path,omitempty" yaml:"path,omitempty"`
+	Policy    string `json:"policy,omitempty" yaml:"policy,omitempty"`
+}
@@ -5,38 +5,52 @@ const (
 	AzureKubernetesServiceConfigFieldAdminUsername        = "adminUsername"
 	AzureKubernetesServiceConfigFieldAgentDNSPrefix       = "agentDnsPrefix"
 	AzureKubernetesServiceConfigFieldAgentPoolName        = "agentPoolName"
+	AzureKubernetesServiceConfigFieldAgentPools           = "agentPools"
 	AzureKubernetesServiceConfigFieldAgentVMSize          = "agentVmSize"
 	AzureKubernetesServiceConfigFieldBaseURL              = "baseUrl"
 	AzureKubernetesServiceConfigFieldClientID             = "clientId"
 	AzureKubernetesServiceConfigFieldClientSecret         = "clientSecret"
 	AzureKubernetesServiceConfigFieldCount                = "count"
+	AzureKubernetesServiceConfigFieldDNSServiceIP         = "dnsServiceIp"
+	AzureKubernetesServiceConfigFieldDockerBridgeCIDR     = "dockerBridgeCidr"
+	AzureKubernetesServiceConfigFieldEnableRBAC           = "enableRbac"
 	AzureKubernetesServiceConfigFieldKubernetesVersion    = "kubernetesVersion"
 	AzureKubernetesServiceConfigFieldLocation             = "location"
 	AzureKubernetesServiceConfigFieldMasterDNSPrefix      = "masterDnsPrefix"
+	AzureKubernetesServiceConfigFieldNetworkPlugin        = "networkPlugin"
 	AzureKubernetesServiceConfigFieldOsDiskSizeGB         = "osDiskSizeGb"
 	AzureKubernetesServiceConfigFieldResourceGroup        = "resourceGroup"
 	AzureKubernetesServiceConfigFieldSSHPublicKeyContents = "sshPublicKeyContents"
+	AzureKubernetesServiceConfigFieldServiceCIDR          = "serviceCidr"
 	AzureKubernetesServiceConfigFieldSubscriptionID       = "subscriptionId"
 	AzureKubernetesServiceConfigFieldTag                  = "tags"
 	AzureKubernetesServiceConfigFieldTenantID             = "tenantId"
+	AzureKubernetesServiceConfigFieldVnetSubnetID         = "vnetSubnetId"
 )
 
 type AzureKubernetesServiceConfig struct {
 	AdminUsername        string            `json:"adminUsername,omitempty" yaml:"adminUsername,omitempty"`
 	AgentDNSPrefix       string            `json:"agentDnsPrefix,omitempty" yaml:"agentDnsPrefix,omitempty"`
 	AgentPoolName        string            `json:"agentPoolName,omitempty" yaml:"agentPoolName,omitempty"`
+	AgentPools           []AKSAgentPool    `json:"agentPools,omitempty" yaml:"agentPools,omitempty"`
 	AgentVMSize          string            `json:"agentVmSize,omitempty" yaml:"agentVmSize,omitempty"`
 	BaseURL              string            `json:"baseUrl,omitempty" yaml:"baseUrl,omitempty"`
 	ClientID             string            `json:"clientId,omitempty" yaml:"clientId,omitempty"`
 	ClientSecret         string            `json:"clientSecret,omitempty" yaml:"clientSecret,omitempty"`
 	Count                int64             `json:"count,omitempty" yaml:"count,omitempty"`
+	DNSServiceIP         string            `json:"dnsServiceIp,omitempty" yaml:"dnsServiceIp,omitempty"`
+	DockerBridgeCIDR     string            `json:"dockerBridgeCidr,omitempty" yaml:"dockerBridgeCidr,omitempty"`
+	EnableRBAC           *bool             `json:"enableRbac,omitempty" yaml:"enableRbac,omitempty"`
 	KubernetesVersion    string            `json:"kubernetesVersion,omitempty" yaml:"kubernetesVersion,omitempty"`
 	Location             string            `json:"location,omitempty" yaml:"location,omitempty"`
 	MasterDNSPrefix      string            `json:"masterDnsPrefix,omitempty" yaml:"masterDnsPrefix,omitempty"`
+	NetworkPlugin        string            `json:"networkPlugin,omitempty" yaml:"networkPlugin,omitempty"`
 	OsDiskSizeGB         int64             `json:"osDiskSizeGb,omitempty" yaml:"osDiskSizeGb,omitempty"`
 	ResourceGroup        string            `json:"resourceGroup,omitempty" yaml:"resourceGroup,omitempty"`
 	SSHPublicKeyContents string            `json:"sshPublicKeyContents,omitempty" yaml:"sshPublicKeyContents,omitempty"`
+	ServiceCIDR          string            `json:"serviceCidr,omitempty" yaml:"serviceCidr,omitempty"`
 	SubscriptionID       string            `json:"subscriptionId,omitempty" yaml:"subscriptionId,omitempty"`
 	Tag                  map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
 	TenantID             string            `json:"tenantId,omitempty" yaml:"tenantId,omitempty"`
+	VnetSubnetID         string            `json:"vnetSubnetId,omitempty" yaml:"vnetSubnetId,omitempty"`
 }
@@ -0,0 +1,14 @@
+package client
+
+const (
+	BlockStorageOpenstackOptsType                 = "blockStorageOpenstackOpts"
+	BlockStorageOpenstackOptsFieldBSVersion       = "bsVersion"
+	BlockStorageOpenstackOptsFieldIgnoreVolumeAZ  = "ignoreVolumeAz"
+	BlockStorageOpenstackOptsFieldTrustDevicePath = "trustDevicePath"
+)
+
+type BlockStorageOpenstackOpts struct {
+	BSVersion       string `json:"bsVersion,omitempty" yaml:"bsVersion,omitempty"`
+	IgnoreVolumeAZ  bool   `json:"ignoreVolumeAz,omitempty" yaml:"ignoreVolumeAz,omitempty"`
+	TrustDevicePath bool   `json:"trustDevicePath,omitempty" yaml:"trustDevicePath,omitempty"`
+}
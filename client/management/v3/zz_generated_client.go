@@ -22,6 +22,9 @@ type Client struct {
 	Cluster                                 ClusterOperations
 	ClusterEvent                            ClusterEventOperations
 	ClusterRegistrationToken                ClusterRegistrationTokenOperations
+	ClusterBackup                           ClusterBackupOperations
+	ClusterTemplate                         ClusterTemplateOperations
+	ClusterTemplateRevision                 ClusterTemplateRevisionOperations
 	Catalog                                 CatalogOperations
 	Template                                TemplateOperations
 	TemplateVersion                         TemplateVersionOperations
@@ -42,6 +45,9 @@ type Client struct {
 	Notifier                                NotifierOperations
 	ClusterAlert                            ClusterAlertOperations
 	ProjectAlert                            ProjectAlertOperations
+	ClusterAlertGroup                       ClusterAlertGroupOperations
+	ProjectAlertGroup                       ProjectAlertGroupOperations
+	MonitorMetric                           MonitorMetricOperations
 	ClusterPipeline                         ClusterPipelineOperations
 	SourceCodeCredential                    SourceCodeCredentialOperations
 	Pipeline                                PipelineOperations
@@ -77,6 +83,9 @@ func NewClient(opts *clientbase.ClientOpts) (*Client, error) {
 	client.Cluster = newClusterClient(client)
 	client.ClusterEvent = newClusterEventClient(client)
 	client.ClusterRegistrationToken = newClusterRegistrationTokenClient(client)
+	client.ClusterBackup = newClusterBackupClient(client)
+	client.ClusterTemplate = newClusterTemplateClient(client)
+	client.ClusterTemplateRevision = newClusterTemplateRevisionClient(client)
 	client.Catalog = newCatalogClient(client)
 	client.Template = newTemplateClient(client)
 	client.TemplateVersion = newTemplateVersionClient(client)
@@ -97,6 +106,9 @@ func NewClient(opts *clientbase.ClientOpts) (*Client, error) {
 	client.Notifier = newNotifierClient(client)
 	client.ClusterAlert = newClusterAlertClient(client)
 	client.ProjectAlert = newProjectAlertClient(client)
+	client.ClusterAlertGroup = newClusterAlertGroupClient(client)
+	client.ProjectAlertGroup = newProjectAlertGroupClient(client)
+	client.MonitorMetric = newMonitorMetricClient(client)
 	client.ClusterPipeline = newClusterPipelineClient(client)
 	client.SourceCodeCredential = newSourceCodeCredentialClient(client)
 	client.Pipeline = newPipelineClient(client)
@@ -16,6 +16,9 @@ const (
 	ClusterFieldAzureKubernetesServiceConfig         = "azureKubernetesServiceConfig"
 	ClusterFieldCACert                               = "caCert"
 	ClusterFieldCapacity                             = "capacity"
+	ClusterFieldClusterTemplateAnswers               = "clusterTemplateAnswers"
+	ClusterFieldClusterTemplateId                    = "clusterTemplateId"
+	ClusterFieldClusterTemplateRevisionId            = "clusterTemplateRevisionId"
 	ClusterFieldComponentStatuses                    = "componentStatuses"
 	ClusterFieldConditions                           = "conditions"
 	ClusterFieldCreated                              = "created"
@@ -25,20 +28,24 @@ const (
 	ClusterFieldDescription                          = "description"
 	ClusterFieldDesiredAgentImage                    = "desiredAgentImage"
 	ClusterFieldDriver                               = "driver"
+	ClusterFieldEnableClusterMonitoring              = "enableClusterMonitoring"
 	ClusterFieldFailedSpec                           = "failedSpec"
 	ClusterFieldGoogleKubernetesEngineConfig         = "googleKubernetesEngineConfig"
 	ClusterFieldImportedConfig                       = "importedConfig"
 	ClusterFieldInternal                             = "internal"
 	ClusterFieldLabels                               = "labels"
 	ClusterFieldLimits                               = "limits"
+	ClusterFieldMonitoringConfig                     = "monitoringConfig"
 	ClusterFieldName                                 = "name"
 	ClusterFieldOwnerReferences                      = "ownerReferences"
+	ClusterFieldPendingPlanChanges                   = "pendingPlanChanges"
 	ClusterFieldRancherKubernetesEngineConfig        = "rancherKubernetesEngineConfig"
 	ClusterFieldRemoved                              = "removed"
 	ClusterFieldRequested                            = "requested"
 	ClusterFieldState                                = "state"
 	ClusterFieldTransitioning                        = "transitioning"
 	ClusterFieldTransitioningMessage                 = "transitioningMessage"
+	ClusterFieldUpgradeStatus                        = "upgradeStatus"
 	ClusterFieldUuid                                 = "uuid"
 	ClusterFieldVersion                              = "version"
 )
@@ -55,6 +62,9 @@ type Cluster struct {
 	AzureKubernetesServiceConfig         *AzureKubernetesServiceConfig        `json:"azureKubernetesServiceConfig,omitempty" yaml:"azureKubernetesServiceConfig,omitempty"`
 	CACert                               string                               `json:"caCert,omitempty" yaml:"caCert,omitempty"`
 	Capacity                             map[string]string                    `json:"capacity,omitempty" yaml:"capacity,omitempty"`
+	ClusterTemplateAnswers               map[string]string                    `json:"clusterTemplateAnswers,omitempty" yaml:"clusterTemplateAnswers,omitempty"`
+	ClusterTemplateId                    string                               `json:"clusterTemplateId,omitempty" yaml:"clusterTemplateId,omitempty"`
+	ClusterTemplateRevisionId            string                               `json:"clusterTemplateRevisionId,omitempty" yaml:"clusterTemplateRevisionId,omitempty"`
 	ComponentStatuses                    []ClusterComponentStatus             `json:"componentStatuses,omitempty" yaml:"componentStatuses,omitempty"`
 	Conditions                           []ClusterCondition                   `json:"conditions,omitempty" yaml:"conditions,omitempty"`
 	Created                              string                               `json:"created,omitempty" yaml:"created,omitempty"`
@@ -64,20 +74,24 @@ type Cluster struct {
 	Description                          string                               `json:"description,omitempty" yaml:"description,omitempty"`
 	DesiredAgentImage                    string                               `json:"desiredAgentImage,omitempty" yaml:"desiredAgentImage,omitempty"`
 	Driver                               string                               `json:"driver,omitempty" yaml:"driver,omitempty"`
+	EnableClusterMonitoring              bool                                 `json:"enableClusterMonitoring,omitempty" yaml:"enableClusterMonitoring,omitempty"`
 	FailedSpec                           *ClusterSpec                         `json:"failedSpec,omitempty" yaml:"failedSpec,omitempty"`
 	GoogleKubernetesEngineConfig         *GoogleKubernetesEngineConfig        `json:"googleKubernetesEngineConfig,omitempty" yaml:"googleKubernetesEngineConfig,omitempty"`
 	ImportedConfig                       *ImportedConfig                      `json:"importedConfig,omitempty" yaml:"importedConfig,omitempty"`
 	Internal                             bool                                 `json:"internal,omitempty" yaml:"internal,omitempty"`
 	Labels                               map[string]string                    `json:"labels,omitempty" yaml:"labels,omitempty"`
 	Limits                               map[string]string                    `json:"limits,omitempty" yaml:"limits,omitempty"`
+	MonitoringConfig                     *MonitoringConfig                    `json:"monitoringConfig,omitempty" yaml:"monitoringConfig,omitempty"`
 	Name                                 string                               `json:"name,omitempty" yaml:"name,omitempty"`
 	OwnerReferences                      []OwnerReference                     `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	PendingPlanChanges                   []NodePlanChange                     `json:"pendingPlanChanges,omitempty" yaml:"pendingPlanChanges,omitempty"`
 	RancherKubernetesEngineConfig        *RancherKubernetesEngineConfig       `json:"rancherKubernetesEngineConfig,omitempty" yaml:"rancherKubernetesEngineConfig,omitempty"`
 	Removed                              string                               `json:"removed,omitempty" yaml:"removed,omitempty"`
 	Requested                            map[string]string                    `json:"requested,omitempty" yaml:"requested,omitempty"`
 	State                                string                               `json:"state,omitempty" yaml:"state,omitempty"`
 	Transitioning                        string                               `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
 	TransitioningMessage                 string                               `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	UpgradeStatus                        *ClusterUpgradeStatus                `json:"upgradeStatus,omitempty" yaml:"upgradeStatus,omitempty"`
 	Uuid                                 string                               `json:"uuid,omitempty" yaml:"uuid,omitempty"`
 	Version                              *Info                                `json:"version,omitempty" yaml:"version,omitempty"`
 }
@@ -98,9 +112,15 @@ type ClusterOperations interface {
 	ByID(id string) (*Cluster, error)
 	Delete(container *Cluster) error
 
+	ActionBackupEtcd(resource *Cluster) (*ClusterBackup, error)
+
 	ActionGenerateKubeconfig(resource *Cluster) (*GenerateKubeConfigOutput, error)
 
 	ActionImportYaml(resource *Cluster, input *ImportClusterYamlInput) (*ImportYamlOutput, error)
+
+	ActionRestoreFromEtcdBackup(resource *Cluster, input *RestoreFromEtcdBackupInput) error
+
+	ActionRollback(resource *Cluster) (*Cluster, error)
 }
 
 func newClusterClient(apiClient *Client) *ClusterClient {
@@ -148,6 +168,12 @@ func (c *ClusterClient) Delete(container *Cluster) error {
 	return c.apiClient.Ops.DoResourceDelete(ClusterType, &container.Resource)
 }
 
+func (c *ClusterClient) ActionBackupEtcd(resource *Cluster) (*ClusterBackup, error) {
+	resp := &ClusterBackup{}
+	err := c.apiClient.Ops.DoAction(ClusterType, "backupEtcd", &resource.Resource, nil, resp)
+	return resp, err
+}
+
 func (c *ClusterClient) ActionGenerateKubeconfig(resource *Cluster) (*GenerateKubeConfigOutput, error) {
 	resp := &GenerateKubeConfigOutput{}
 	err := c.apiClient.Ops.DoAction(ClusterType, "generateKubeconfig", &resource.Resource, nil, resp)
@@ -159,3 +185,14 @@ func (c *ClusterClient) ActionImportYaml(resource *Cluster, input *ImportCluster
 	err := c.apiClient.Ops.DoAction(ClusterType, "importYaml", &resource.Resource, input, resp)
 	return resp, err
 }
+
+func (c *ClusterClient) ActionRestoreFromEtcdBackup(resource *Cluster, input *RestoreFromEtcdBackupInput) error {
+	err := c.apiClient.Ops.DoAction(ClusterType, "restoreFromEtcdBackup", &resource.Resource, input, nil)
+	return err
+}
+
+func (c *ClusterClient) ActionRollback(resource *Cluster) (*Cluster, error) {
+	resp := &Cluster{}
+	err := c.apiClient.Ops.DoAction(ClusterType, "rollback", &resource.Resource, nil, resp)
+	return resp, err
+}
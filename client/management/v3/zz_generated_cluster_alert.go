@@ -12,8 +12,11 @@ const (
 	ClusterAlertFieldCreated               = "created"
 	ClusterAlertFieldCreatorID             = "creatorId"
 	ClusterAlertFieldDescription           = "description"
+	ClusterAlertFieldGroupId               = "groupId"
+	ClusterAlertFieldInhibitRules          = "inhibitRules"
 	ClusterAlertFieldInitialWaitSeconds    = "initialWaitSeconds"
 	ClusterAlertFieldLabels                = "labels"
+	ClusterAlertFieldMessageTemplate       = "messageTemplate"
 	ClusterAlertFieldName                  = "name"
 	ClusterAlertFieldNamespaceId           = "namespaceId"
 	ClusterAlertFieldOwnerReferences       = "ownerReferences"
@@ -21,8 +24,11 @@ const (
 	ClusterAlertFieldRemoved               = "removed"
 	ClusterAlertFieldRepeatIntervalSeconds = "repeatIntervalSeconds"
 	ClusterAlertFieldSeverity              = "severity"
+	ClusterAlertFieldSilenceWindows        = "silenceWindows"
 	ClusterAlertFieldState                 = "state"
+	ClusterAlertFieldSuppression           = "suppression"
 	ClusterAlertFieldTargetEvent           = "targetEvent"
+	ClusterAlertFieldTargetMetric          = "targetMetric"
 	ClusterAlertFieldTargetNode            = "targetNode"
 	ClusterAlertFieldTargetSystemService   = "targetSystemService"
 	ClusterAlertFieldTransitioning         = "transitioning"
@@ -38,8 +44,11 @@ type ClusterAlert struct {
 	Created               string               `json:"created,omitempty" yaml:"created,omitempty"`
 	CreatorID             string               `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
 	Description           string               `json:"description,omitempty" yaml:"description,omitempty"`
+	GroupId               string               `json:"groupId,omitempty" yaml:"groupId,omitempty"`
+	InhibitRules          []InhibitRule        `json:"inhibitRules,omitempty" yaml:"inhibitRules,omitempty"`
 	InitialWaitSeconds    int64                `json:"initialWaitSeconds,omitempty" yaml:"initialWaitSeconds,omitempty"`
 	Labels                map[string]string    `json:"labels,omitempty" yaml:"labels,omitempty"`
+	MessageTemplate       string               `json:"messageTemplate,omitempty" yaml:"messageTemplate,omitempty"`
 	Name                  string               `json:"name,omitempty" yaml:"name,omitempty"`
 	NamespaceId           string               `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
 	OwnerReferences       []OwnerReference     `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
@@ -47,8 +56,11 @@ type ClusterAlert struct {
 	Removed               string               `json:"removed,omitempty" yaml:"removed,omitempty"`
 	RepeatIntervalSeconds int64                `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
 	Severity              string               `json:"severity,omitempty" yaml:"severity,omitempty"`
+	SilenceWindows        []SilenceWindow      `json:"silenceWindows,omitempty" yaml:"silenceWindows,omitempty"`
 	State                 string               `json:"state,omitempty" yaml:"state,omitempty"`
+	Suppression           *AlertSuppression    `json:"suppression,omitempty" yaml:"suppression,omitempty"`
 	TargetEvent           *TargetEvent         `json:"targetEvent,omitempty" yaml:"targetEvent,omitempty"`
+	TargetMetric          *MetricRule          `json:"targetMetric,omitempty" yaml:"targetMetric,omitempty"`
 	TargetNode            *TargetNode          `json:"targetNode,omitempty" yaml:"targetNode,omitempty"`
 	TargetSystemService   *TargetSystemService `json:"targetSystemService,omitempty" yaml:"targetSystemService,omitempty"`
 	Transitioning         string               `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
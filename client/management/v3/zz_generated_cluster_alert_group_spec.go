@@ -0,0 +1,22 @@
+package client
+
+const (
+	ClusterAlertGroupSpecType                       = "clusterAlertGroupSpec"
+	ClusterAlertGroupSpecFieldClusterId             = "clusterId"
+	ClusterAlertGroupSpecFieldDescription           = "description"
+	ClusterAlertGroupSpecFieldDisplayName           = "displayName"
+	ClusterAlertGroupSpecFieldGroupBy               = "groupBy"
+	ClusterAlertGroupSpecFieldGroupIntervalSeconds  = "groupIntervalSeconds"
+	ClusterAlertGroupSpecFieldGroupWaitSeconds      = "groupWaitSeconds"
+	ClusterAlertGroupSpecFieldRepeatIntervalSeconds = "repeatIntervalSeconds"
+)
+
+type ClusterAlertGroupSpec struct {
+	ClusterId             string   `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Description           string   `json:"description,omitempty" yaml:"description,omitempty"`
+	DisplayName           string   `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	GroupBy               []string `json:"groupBy,omitempty" yaml:"groupBy,omitempty"`
+	GroupIntervalSeconds  int64    `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
+	GroupWaitSeconds      int64    `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
+	RepeatIntervalSeconds int64    `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
+}
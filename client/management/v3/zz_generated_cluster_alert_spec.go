@@ -5,11 +5,16 @@ const (
 	ClusterAlertSpecFieldClusterId             = "clusterId"
 	ClusterAlertSpecFieldDescription           = "description"
 	ClusterAlertSpecFieldDisplayName           = "displayName"
+	ClusterAlertSpecFieldGroupId               = "groupId"
+	ClusterAlertSpecFieldInhibitRules          = "inhibitRules"
 	ClusterAlertSpecFieldInitialWaitSeconds    = "initialWaitSeconds"
+	ClusterAlertSpecFieldMessageTemplate       = "messageTemplate"
 	ClusterAlertSpecFieldRecipients            = "recipients"
 	ClusterAlertSpecFieldRepeatIntervalSeconds = "repeatIntervalSeconds"
 	ClusterAlertSpecFieldSeverity              = "severity"
+	ClusterAlertSpecFieldSilenceWindows        = "silenceWindows"
 	ClusterAlertSpecFieldTargetEvent           = "targetEvent"
+	ClusterAlertSpecFieldTargetMetric          = "targetMetric"
 	ClusterAlertSpecFieldTargetNode            = "targetNode"
 	ClusterAlertSpecFieldTargetSystemService   = "targetSystemService"
 )
@@ -18,11 +23,16 @@ type ClusterAlertSpec struct {
 	ClusterId             string               `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
 	Description           string               `json:"description,omitempty" yaml:"description,omitempty"`
 	DisplayName           string               `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	GroupId               string               `json:"groupId,omitempty" yaml:"groupId,omitempty"`
+	InhibitRules          []InhibitRule        `json:"inhibitRules,omitempty" yaml:"inhibitRules,omitempty"`
 	InitialWaitSeconds    int64                `json:"initialWaitSeconds,omitempty" yaml:"initialWaitSeconds,omitempty"`
+	MessageTemplate       string               `json:"messageTemplate,omitempty" yaml:"messageTemplate,omitempty"`
 	Recipients            []Recipient          `json:"recipients,omitempty" yaml:"recipients,omitempty"`
 	RepeatIntervalSeconds int64                `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
 	Severity              string               `json:"severity,omitempty" yaml:"severity,omitempty"`
+	SilenceWindows        []SilenceWindow      `json:"silenceWindows,omitempty" yaml:"silenceWindows,omitempty"`
 	TargetEvent           *TargetEvent         `json:"targetEvent,omitempty" yaml:"targetEvent,omitempty"`
+	TargetMetric          *MetricRule          `json:"targetMetric,omitempty" yaml:"targetMetric,omitempty"`
 	TargetNode            *TargetNode          `json:"targetNode,omitempty" yaml:"targetNode,omitempty"`
 	TargetSystemService   *TargetSystemService `json:"targetSystemService,omitempty" yaml:"targetSystemService,omitempty"`
 }
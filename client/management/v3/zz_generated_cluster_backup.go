@@ -0,0 +1,115 @@
+package client
+
+import (
+	"github.com/rancher/norman/types"
+)
+
+const (
+	ClusterBackupType                      = "clusterBackup"
+	ClusterBackupFieldAnnotations          = "annotations"
+	ClusterBackupFieldBackupConfig         = "backupConfig"
+	ClusterBackupFieldClusterId            = "clusterId"
+	ClusterBackupFieldCompletedAt          = "completedAt"
+	ClusterBackupFieldConditions           = "conditions"
+	ClusterBackupFieldCreated              = "created"
+	ClusterBackupFieldCreatorID            = "creatorId"
+	ClusterBackupFieldFilename             = "filename"
+	ClusterBackupFieldLabels               = "labels"
+	ClusterBackupFieldManual               = "manual"
+	ClusterBackupFieldName                 = "name"
+	ClusterBackupFieldNamespaceId          = "namespaceId"
+	ClusterBackupFieldOwnerReferences      = "ownerReferences"
+	ClusterBackupFieldRemoved              = "removed"
+	ClusterBackupFieldS3Key                = "s3Key"
+	ClusterBackupFieldSize                 = "size"
+	ClusterBackupFieldState                = "state"
+	ClusterBackupFieldTransitioning        = "transitioning"
+	ClusterBackupFieldTransitioningMessage = "transitioningMessage"
+	ClusterBackupFieldUuid                 = "uuid"
+)
+
+type ClusterBackup struct {
+	types.Resource
+	Annotations          map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	BackupConfig         *BackupConfig     `json:"backupConfig,omitempty" yaml:"backupConfig,omitempty"`
+	ClusterId            string            `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	CompletedAt          string            `json:"completedAt,omitempty" yaml:"completedAt,omitempty"`
+	Conditions           []Condition       `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	Created              string            `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID            string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Filename             string            `json:"filename,omitempty" yaml:"filename,omitempty"`
+	Labels               map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Manual               bool              `json:"manual,omitempty" yaml:"manual,omitempty"`
+	Name                 string            `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceId          string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OwnerReferences      []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	Removed              string            `json:"removed,omitempty" yaml:"removed,omitempty"`
+	S3Key                string            `json:"s3Key,omitempty" yaml:"s3Key,omitempty"`
+	Size                 int64             `json:"size,omitempty" yaml:"size,omitempty"`
+	State                string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Transitioning        string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage string            `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	Uuid                 string            `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+}
+type ClusterBackupCollection struct {
+	types.Collection
+	Data   []ClusterBackup `json:"data,omitempty"`
+	client *ClusterBackupClient
+}
+
+type ClusterBackupClient struct {
+	apiClient *Client
+}
+
+type ClusterBackupOperations interface {
+	List(opts *types.ListOpts) (*ClusterBackupCollection, error)
+	Create(opts *ClusterBackup) (*ClusterBackup, error)
+	Update(existing *ClusterBackup, updates interface{}) (*ClusterBackup, error)
+	ByID(id string) (*ClusterBackup, error)
+	Delete(container *ClusterBackup) error
+}
+
+func newClusterBackupClient(apiClient *Client) *ClusterBackupClient {
+	return &ClusterBackupClient{
+		apiClient: apiClient,
+	}
+}
+
+func (c *ClusterBackupClient) Create(container *ClusterBackup) (*ClusterBackup, error) {
+	resp := &ClusterBackup{}
+	err := c.apiClient.Ops.DoCreate(ClusterBackupType, container, resp)
+	return resp, err
+}
+
+func (c *ClusterBackupClient) Update(existing *ClusterBackup, updates interface{}) (*ClusterBackup, error) {
+	resp := &ClusterBackup{}
+	err := c.apiClient.Ops.DoUpdate(ClusterBackupType, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *ClusterBackupClient) List(opts *types.ListOpts) (*ClusterBackupCollection, error) {
+	resp := &ClusterBackupCollection{}
+	err := c.apiClient.Ops.DoList(ClusterBackupType, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (cc *ClusterBackupCollection) Next() (*ClusterBackupCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &ClusterBackupCollection{}
+		err := cc.client.apiClient.Ops.DoNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *ClusterBackupClient) ByID(id string) (*ClusterBackup, error) {
+	resp := &ClusterBackup{}
+	err := c.apiClient.Ops.DoByID(ClusterBackupType, id, resp)
+	return resp, err
+}
+
+func (c *ClusterBackupClient) Delete(container *ClusterBackup) error {
+	return c.apiClient.Ops.DoResourceDelete(ClusterBackupType, &container.Resource)
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	ClusterBackupSpecType              = "clusterBackupSpec"
+	ClusterBackupSpecFieldBackupConfig = "backupConfig"
+	ClusterBackupSpecFieldClusterId    = "clusterId"
+	ClusterBackupSpecFieldFilename     = "filename"
+	ClusterBackupSpecFieldManual       = "manual"
+)
+
+type ClusterBackupSpec struct {
+	BackupConfig *BackupConfig `json:"backupConfig,omitempty" yaml:"backupConfig,omitempty"`
+	ClusterId    string        `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Filename     string        `json:"filename,omitempty" yaml:"filename,omitempty"`
+	Manual       bool          `json:"manual,omitempty" yaml:"manual,omitempty"`
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	ClusterBackupStatusType             = "clusterBackupStatus"
+	ClusterBackupStatusFieldCompletedAt = "completedAt"
+	ClusterBackupStatusFieldConditions  = "conditions"
+	ClusterBackupStatusFieldS3Key       = "s3Key"
+	ClusterBackupStatusFieldSize        = "size"
+)
+
+type ClusterBackupStatus struct {
+	CompletedAt string      `json:"completedAt,omitempty" yaml:"completedAt,omitempty"`
+	Conditions  []Condition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	S3Key       string      `json:"s3Key,omitempty" yaml:"s3Key,omitempty"`
+	Size        int64       `json:"size,omitempty" yaml:"size,omitempty"`
+}
@@ -14,7 +14,9 @@ const (
 	ClusterStatusFieldDriver                               = "driver"
 	ClusterStatusFieldFailedSpec                           = "failedSpec"
 	ClusterStatusFieldLimits                               = "limits"
+	ClusterStatusFieldPendingPlanChanges                   = "pendingPlanChanges"
 	ClusterStatusFieldRequested                            = "requested"
+	ClusterStatusFieldUpgradeStatus                        = "upgradeStatus"
 	ClusterStatusFieldVersion                              = "version"
 )
 
@@ -31,6 +33,8 @@ type ClusterStatus struct {
 	Driver                               string                   `json:"driver,omitempty" yaml:"driver,omitempty"`
 	FailedSpec                           *ClusterSpec             `json:"failedSpec,omitempty" yaml:"failedSpec,omitempty"`
 	Limits                               map[string]string        `json:"limits,omitempty" yaml:"limits,omitempty"`
+	PendingPlanChanges                   []NodePlanChange         `json:"pendingPlanChanges,omitempty" yaml:"pendingPlanChanges,omitempty"`
 	Requested                            map[string]string        `json:"requested,omitempty" yaml:"requested,omitempty"`
+	UpgradeStatus                        *ClusterUpgradeStatus    `json:"upgradeStatus,omitempty" yaml:"upgradeStatus,omitempty"`
 	Version                              *Info                    `json:"version,omitempty" yaml:"version,omitempty"`
 }
@@ -0,0 +1,18 @@
+package client
+
+const (
+	ClusterTemplateRevisionSpecType                   = "clusterTemplateRevisionSpec"
+	ClusterTemplateRevisionSpecFieldClusterConfig     = "clusterConfig"
+	ClusterTemplateRevisionSpecFieldClusterTemplateId = "clusterTemplateId"
+	ClusterTemplateRevisionSpecFieldDisplayName       = "displayName"
+	ClusterTemplateRevisionSpecFieldEnabled           = "enabled"
+	ClusterTemplateRevisionSpecFieldQuestions         = "questions"
+)
+
+type ClusterTemplateRevisionSpec struct {
+	ClusterConfig     *ClusterSpec `json:"clusterConfig,omitempty" yaml:"clusterConfig,omitempty"`
+	ClusterTemplateId string       `json:"clusterTemplateId,omitempty" yaml:"clusterTemplateId,omitempty"`
+	DisplayName       string       `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	Enabled           *bool        `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Questions         []Question   `json:"questions,omitempty" yaml:"questions,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	ClusterTemplateSpecType                   = "clusterTemplateSpec"
+	ClusterTemplateSpecFieldDefaultRevisionId = "defaultRevisionId"
+	ClusterTemplateSpecFieldDescription       = "description"
+	ClusterTemplateSpecFieldDisplayName       = "displayName"
+)
+
+type ClusterTemplateSpec struct {
+	DefaultRevisionId string `json:"defaultRevisionId,omitempty" yaml:"defaultRevisionId,omitempty"`
+	Description       string `json:"description,omitempty" yaml:"description,omitempty"`
+	DisplayName       string `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	ClusterUpgradeStatusType               = "clusterUpgradeStatus"
+	ClusterUpgradeStatusFieldNodes         = "nodes"
+	ClusterUpgradeStatusFieldTargetVersion = "targetVersion"
+)
+
+type ClusterUpgradeStatus struct {
+	Nodes         []NodeUpgradeProgress `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+	TargetVersion string                `json:"targetVersion,omitempty" yaml:"targetVersion,omitempty"`
+}
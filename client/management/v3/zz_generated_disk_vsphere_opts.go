@@ -0,0 +1,10 @@
+package client
+
+const (
+	DiskVsphereOptsType                    = "diskVsphereOpts"
+	DiskVsphereOptsFieldSCSIControllerType = "scsiControllerType"
+)
+
+type DiskVsphereOpts struct {
+	SCSIControllerType string `json:"scsiControllerType,omitempty" yaml:"scsiControllerType,omitempty"`
+}
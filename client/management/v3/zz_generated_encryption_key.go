@@ -0,0 +1,12 @@
+package client
+
+const (
+	EncryptionKeyType        = "encryptionKey"
+	EncryptionKeyFieldName   = "name"
+	EncryptionKeyFieldSecret = "secret"
+)
+
+type EncryptionKey struct {
+	Name   string `json:"name,omitempty" yaml:"name,omitempty"`
+	Secret string `json:"secret,omitempty" yaml:"secret,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	EncryptionProviderType      = "encryptionProvider"
+	EncryptionProviderFieldKeys = "keys"
+	EncryptionProviderFieldType = "type"
+)
+
+type EncryptionProvider struct {
+	Keys []EncryptionKey `json:"keys,omitempty" yaml:"keys,omitempty"`
+	Type string          `json:"type,omitempty" yaml:"type,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	GKECidrBlockType             = "gkeCidrBlock"
+	GKECidrBlockFieldCIDRBlock   = "cidrBlock"
+	GKECidrBlockFieldDisplayName = "displayName"
+)
+
+type GKECidrBlock struct {
+	CIDRBlock   string `json:"cidrBlock,omitempty" yaml:"cidrBlock,omitempty"`
+	DisplayName string `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+}
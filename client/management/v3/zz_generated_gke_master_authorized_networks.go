@@ -0,0 +1,12 @@
+package client
+
+const (
+	GKEMasterAuthorizedNetworksType            = "gkeMasterAuthorizedNetworks"
+	GKEMasterAuthorizedNetworksFieldCIDRBlocks = "cidrBlocks"
+	GKEMasterAuthorizedNetworksFieldEnabled    = "enabled"
+)
+
+type GKEMasterAuthorizedNetworks struct {
+	CIDRBlocks []GKECidrBlock `json:"cidrBlocks,omitempty" yaml:"cidrBlocks,omitempty"`
+	Enabled    bool           `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+}
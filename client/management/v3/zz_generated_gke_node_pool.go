@@ -0,0 +1,26 @@
+package client
+
+const (
+	GKENodePoolType                  = "gkeNodePool"
+	GKENodePoolFieldAutoscaling      = "autoscaling"
+	GKENodePoolFieldDiskSizeGb       = "diskSizeGb"
+	GKENodePoolFieldImageType        = "imageType"
+	GKENodePoolFieldInitialNodeCount = "initialNodeCount"
+	GKENodePoolFieldLabels           = "labels"
+	GKENodePoolFieldMachineType      = "machineType"
+	GKENodePoolFieldName             = "name"
+	GKENodePoolFieldPreemptible      = "preemptible"
+	GKENodePoolFieldTaints           = "taints"
+)
+
+type GKENodePool struct {
+	Autoscaling      *GKENodePoolAutoscaling `json:"autoscaling,omitempty" yaml:"autoscaling,omitempty"`
+	DiskSizeGb       int64                   `json:"diskSizeGb,omitempty" yaml:"diskSizeGb,omitempty"`
+	ImageType        string                  `json:"imageType,omitempty" yaml:"imageType,omitempty"`
+	InitialNodeCount int64                   `json:"initialNodeCount,omitempty" yaml:"initialNodeCount,omitempty"`
+	Labels           map[string]string       `json:"labels,omitempty" yaml:"labels,omitempty"`
+	MachineType      string                  `json:"machineType,omitempty" yaml:"machineType,omitempty"`
+	Name             string                  `json:"name,omitempty" yaml:"name,omitempty"`
+	Preemptible      bool                    `json:"preemptible,omitempty" yaml:"preemptible,omitempty"`
+	Taints           []Taint                 `json:"taints,omitempty" yaml:"taints,omitempty"`
+}
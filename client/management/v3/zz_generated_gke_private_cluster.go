@@ -0,0 +1,14 @@
+package client
+
+const (
+	GKEPrivateClusterType                       = "gkePrivateCluster"
+	GKEPrivateClusterFieldEnablePrivateEndpoint = "enablePrivateEndpoint"
+	GKEPrivateClusterFieldEnablePrivateNodes    = "enablePrivateNodes"
+	GKEPrivateClusterFieldMasterIpv4CidrBlock   = "masterIpv4CidrBlock"
+)
+
+type GKEPrivateCluster struct {
+	EnablePrivateEndpoint bool   `json:"enablePrivateEndpoint,omitempty" yaml:"enablePrivateEndpoint,omitempty"`
+	EnablePrivateNodes    bool   `json:"enablePrivateNodes,omitempty" yaml:"enablePrivateNodes,omitempty"`
+	MasterIpv4CidrBlock   string `json:"masterIpv4CidrBlock,omitempty" yaml:"masterIpv4CidrBlock,omitempty"`
+}
@@ -0,0 +1,28 @@
+package client
+
+const (
+	GlobalAwsOptsType                             = "globalAwsOpts"
+	GlobalAwsOptsFieldDisableSecurityGroupIngress = "disableSecurityGroupIngress"
+	GlobalAwsOptsFieldDisableStrictZoneCheck      = "disableStrictZoneCheck"
+	GlobalAwsOptsFieldElbSecurityGroup            = "elbSecurityGroup"
+	GlobalAwsOptsFieldKubernetesClusterID         = "kubernetesClusterId"
+	GlobalAwsOptsFieldKubernetesClusterTag        = "kubernetesClusterTag"
+	GlobalAwsOptsFieldRoleARN                     = "roleArn"
+	GlobalAwsOptsFieldRouteTableID                = "routeTableId"
+	GlobalAwsOptsFieldSubnetID                    = "subnetId"
+	GlobalAwsOptsFieldVPC                         = "vpc"
+	GlobalAwsOptsFieldZone                        = "zone"
+)
+
+type GlobalAwsOpts struct {
+	DisableSecurityGroupIngress bool   `json:"disableSecurityGroupIngress,omitempty" yaml:"disableSecurityGroupIngress,omitempty"`
+	DisableStrictZoneCheck      bool   `json:"disableStrictZoneCheck,omitempty" yaml:"disableStrictZoneCheck,omitempty"`
+	ElbSecurityGroup            string `json:"elbSecurityGroup,omitempty" yaml:"elbSecurityGroup,omitempty"`
+	KubernetesClusterID         string `json:"kubernetesClusterId,omitempty" yaml:"kubernetesClusterId,omitempty"`
+	KubernetesClusterTag        string `json:"kubernetesClusterTag,omitempty" yaml:"kubernetesClusterTag,omitempty"`
+	RoleARN                     string `json:"roleArn,omitempty" yaml:"roleArn,omitempty"`
+	RouteTableID                string `json:"routeTableId,omitempty" yaml:"routeTableId,omitempty"`
+	SubnetID                    string `json:"subnetId,omitempty" yaml:"subnetId,omitempty"`
+	VPC                         string `json:"vpc,omitempty" yaml:"vpc,omitempty"`
+	Zone                        string `json:"zone,omitempty" yaml:"zone,omitempty"`
+}
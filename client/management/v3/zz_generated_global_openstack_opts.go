@@ -0,0 +1,32 @@
+package client
+
+const (
+	GlobalOpenstackOptsType                   = "globalOpenstackOpts"
+	GlobalOpenstackOptsFieldAuthURL           = "authUrl"
+	GlobalOpenstackOptsFieldCAFile            = "caFile"
+	GlobalOpenstackOptsFieldDomainID          = "domainId"
+	GlobalOpenstackOptsFieldDomainName        = "domainName"
+	GlobalOpenstackOptsFieldPassword          = "password"
+	GlobalOpenstackOptsFieldPasswordSecretRef = "passwordSecretRef"
+	GlobalOpenstackOptsFieldRegion            = "region"
+	GlobalOpenstackOptsFieldTenantID          = "tenantId"
+	GlobalOpenstackOptsFieldTenantName        = "tenantName"
+	GlobalOpenstackOptsFieldTrustID           = "trustId"
+	GlobalOpenstackOptsFieldUserID            = "userId"
+	GlobalOpenstackOptsFieldUsername          = "username"
+)
+
+type GlobalOpenstackOpts struct {
+	AuthURL           string        `json:"authUrl,omitempty" yaml:"authUrl,omitempty"`
+	CAFile            string        `json:"caFile,omitempty" yaml:"caFile,omitempty"`
+	DomainID          string        `json:"domainId,omitempty" yaml:"domainId,omitempty"`
+	DomainName        string        `json:"domainName,omitempty" yaml:"domainName,omitempty"`
+	Password          string        `json:"password,omitempty" yaml:"password,omitempty"`
+	PasswordSecretRef *SecretKeyRef `json:"passwordSecretRef,omitempty" yaml:"passwordSecretRef,omitempty"`
+	Region            string        `json:"region,omitempty" yaml:"region,omitempty"`
+	TenantID          string        `json:"tenantId,omitempty" yaml:"tenantId,omitempty"`
+	TenantName        string        `json:"tenantName,omitempty" yaml:"tenantName,omitempty"`
+	TrustID           string        `json:"trustId,omitempty" yaml:"trustId,omitempty"`
+	UserID            string        `json:"userId,omitempty" yaml:"userId,omitempty"`
+	Username          string        `json:"username,omitempty" yaml:"username,omitempty"`
+}
@@ -0,0 +1,34 @@
+package client
+
+const (
+	GlobalVsphereOptsType                   = "globalVsphereOpts"
+	GlobalVsphereOptsFieldDatacenter        = "datacenter"
+	GlobalVsphereOptsFieldDatacenters       = "datacenters"
+	GlobalVsphereOptsFieldDefaultDatastore  = "datastore"
+	GlobalVsphereOptsFieldInsecureFlag      = "insecureFlag"
+	GlobalVsphereOptsFieldPassword          = "password"
+	GlobalVsphereOptsFieldPasswordSecretRef = "passwordSecretRef"
+	GlobalVsphereOptsFieldRoundTripperCount = "soapRoundtripCount"
+	GlobalVsphereOptsFieldUser              = "user"
+	GlobalVsphereOptsFieldVCenterIP         = "server"
+	GlobalVsphereOptsFieldVCenterPort       = "port"
+	GlobalVsphereOptsFieldVMName            = "vmName"
+	GlobalVsphereOptsFieldVMUUID            = "vmUuid"
+	GlobalVsphereOptsFieldWorkingDir        = "workingDir"
+)
+
+type GlobalVsphereOpts struct {
+	Datacenter        string        `json:"datacenter,omitempty" yaml:"datacenter,omitempty"`
+	Datacenters       string        `json:"datacenters,omitempty" yaml:"datacenters,omitempty"`
+	DefaultDatastore  string        `json:"datastore,omitempty" yaml:"datastore,omitempty"`
+	InsecureFlag      bool          `json:"insecureFlag,omitempty" yaml:"insecureFlag,omitempty"`
+	Password          string        `json:"password,omitempty" yaml:"password,omitempty"`
+	PasswordSecretRef *SecretKeyRef `json:"passwordSecretRef,omitempty" yaml:"passwordSecretRef,omitempty"`
+	RoundTripperCount int64         `json:"soapRoundtripCount,omitempty" yaml:"soapRoundtripCount,omitempty"`
+	User              string        `json:"user,omitempty" yaml:"user,omitempty"`
+	VCenterIP         string        `json:"server,omitempty" yaml:"server,omitempty"`
+	VCenterPort       string        `json:"port,omitempty" yaml:"port,omitempty"`
+	VMName            string        `json:"vmName,omitempty" yaml:"vmName,omitempty"`
+	VMUUID            string        `json:"vmUuid,omitempty" yaml:"vmUuid,omitempty"`
+	WorkingDir        string        `json:"workingDir,omitempty" yaml:"workingDir,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	HTTPBasicAuthType          = "httpBasicAuth"
+	HTTPBasicAuthFieldPassword = "password"
+	HTTPBasicAuthFieldUsername = "username"
+)
+
+type HTTPBasicAuth struct {
+	Password string `json:"password,omitempty" yaml:"password,omitempty"`
+	Username string `json:"username,omitempty" yaml:"username,omitempty"`
+}
@@ -0,0 +1,18 @@
+package client
+
+const (
+	HTTPTLSConfigType                    = "httptlsConfig"
+	HTTPTLSConfigFieldCACert             = "caCert"
+	HTTPTLSConfigFieldClientCert         = "clientCert"
+	HTTPTLSConfigFieldClientKey          = "clientKey"
+	HTTPTLSConfigFieldInsecureSkipVerify = "insecureSkipVerify"
+	HTTPTLSConfigFieldServerName         = "serverName"
+)
+
+type HTTPTLSConfig struct {
+	CACert             string `json:"caCert,omitempty" yaml:"caCert,omitempty"`
+	ClientCert         string `json:"clientCert,omitempty" yaml:"clientCert,omitempty"`
+	ClientKey          string `json:"clientKey,omitempty" yaml:"clientKey,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty" yaml:"insecureSkipVerify,omitempty"`
+	ServerName         string `json:"serverName,omitempty" yaml:"serverName,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	InhibitRuleType                = "inhibitRule"
+	InhibitRuleFieldEqual          = "equal"
+	InhibitRuleFieldTargetMatchers = "targetMatchers"
+)
+
+type InhibitRule struct {
+	Equal          []string          `json:"equal,omitempty" yaml:"equal,omitempty"`
+	TargetMatchers map[string]string `json:"targetMatchers,omitempty" yaml:"targetMatchers,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	KeyRotationType                = "keyRotation"
+	KeyRotationFieldActiveKey      = "activeKey"
+	KeyRotationFieldRewriteSecrets = "rewriteSecrets"
+)
+
+type KeyRotation struct {
+	ActiveKey      string `json:"activeKey,omitempty" yaml:"activeKey,omitempty"`
+	RewriteSecrets bool   `json:"rewriteSecrets,omitempty" yaml:"rewriteSecrets,omitempty"`
+}
@@ -1,18 +1,22 @@
 package client
 
 const (
-	KubeAPIServiceType                       = "kubeAPIService"
-	KubeAPIServiceFieldExtraArgs             = "extraArgs"
-	KubeAPIServiceFieldExtraBinds            = "extraBinds"
-	KubeAPIServiceFieldImage                 = "image"
-	KubeAPIServiceFieldPodSecurityPolicy     = "podSecurityPolicy"
-	KubeAPIServiceFieldServiceClusterIPRange = "serviceClusterIpRange"
+	KubeAPIServiceType                         = "kubeAPIService"
+	KubeAPIServiceFieldAuditLog                = "auditLog"
+	KubeAPIServiceFieldExtraArgs               = "extraArgs"
+	KubeAPIServiceFieldExtraBinds              = "extraBinds"
+	KubeAPIServiceFieldImage                   = "image"
+	KubeAPIServiceFieldPodSecurityPolicy       = "podSecurityPolicy"
+	KubeAPIServiceFieldSecretsEncryptionConfig = "secretsEncryptionConfig"
+	KubeAPIServiceFieldServiceClusterIPRange   = "serviceClusterIpRange"
 )
 
 type KubeAPIService struct {
-	ExtraArgs             map[string]string `json:"extraArgs,omitempty" yaml:"extraArgs,omitempty"`
-	ExtraBinds            []string          `json:"extraBinds,omitempty" yaml:"extraBinds,omitempty"`
-	Image                 string            `json:"image,omitempty" yaml:"image,omitempty"`
-	PodSecurityPolicy     bool              `json:"podSecurityPolicy,omitempty" yaml:"podSecurityPolicy,omitempty"`
-	ServiceClusterIPRange string            `json:"serviceClusterIpRange,omitempty" yaml:"serviceClusterIpRange,omitempty"`
+	AuditLog                *AuditLog                `json:"auditLog,omitempty" yaml:"auditLog,omitempty"`
+	ExtraArgs               map[string]string        `json:"extraArgs,omitempty" yaml:"extraArgs,omitempty"`
+	ExtraBinds              []string                 `json:"extraBinds,omitempty" yaml:"extraBinds,omitempty"`
+	Image                   string                   `json:"image,omitempty" yaml:"image,omitempty"`
+	PodSecurityPolicy       bool                     `json:"podSecurityPolicy,omitempty" yaml:"podSecurityPolicy,omitempty"`
+	SecretsEncryptionConfig *SecretsEncryptionConfig `json:"secretsEncryptionConfig,omitempty" yaml:"secretsEncryptionConfig,omitempty"`
+	ServiceClusterIPRange   string                   `json:"serviceClusterIpRange,omitempty" yaml:"serviceClusterIpRange,omitempty"`
 }
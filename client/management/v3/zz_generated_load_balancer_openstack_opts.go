@@ -0,0 +1,30 @@
+package client
+
+const (
+	LoadBalancerOpenstackOptsType                      = "loadBalancerOpenstackOpts"
+	LoadBalancerOpenstackOptsFieldCreateMonitor        = "createMonitor"
+	LoadBalancerOpenstackOptsFieldFloatingNetworkID    = "floatingNetworkId"
+	LoadBalancerOpenstackOptsFieldLBMethod             = "lbMethod"
+	LoadBalancerOpenstackOptsFieldLBProvider           = "lbProvider"
+	LoadBalancerOpenstackOptsFieldLBVersion            = "lbVersion"
+	LoadBalancerOpenstackOptsFieldManageSecurityGroups = "manageSecurityGroups"
+	LoadBalancerOpenstackOptsFieldMonitorDelay         = "monitorDelay"
+	LoadBalancerOpenstackOptsFieldMonitorMaxRetries    = "monitorMaxRetries"
+	LoadBalancerOpenstackOptsFieldMonitorTimeout       = "monitorTimeout"
+	LoadBalancerOpenstackOptsFieldSubnetID             = "subnetId"
+	LoadBalancerOpenstackOptsFieldUseOctavia           = "useOctavia"
+)
+
+type LoadBalancerOpenstackOpts struct {
+	CreateMonitor        bool   `json:"createMonitor,omitempty" yaml:"createMonitor,omitempty"`
+	FloatingNetworkID    string `json:"floatingNetworkId,omitempty" yaml:"floatingNetworkId,omitempty"`
+	LBMethod             string `json:"lbMethod,omitempty" yaml:"lbMethod,omitempty"`
+	LBProvider           string `json:"lbProvider,omitempty" yaml:"lbProvider,omitempty"`
+	LBVersion            string `json:"lbVersion,omitempty" yaml:"lbVersion,omitempty"`
+	ManageSecurityGroups bool   `json:"manageSecurityGroups,omitempty" yaml:"manageSecurityGroups,omitempty"`
+	MonitorDelay         string `json:"monitorDelay,omitempty" yaml:"monitorDelay,omitempty"`
+	MonitorMaxRetries    int64  `json:"monitorMaxRetries,omitempty" yaml:"monitorMaxRetries,omitempty"`
+	MonitorTimeout       string `json:"monitorTimeout,omitempty" yaml:"monitorTimeout,omitempty"`
+	SubnetID             string `json:"subnetId,omitempty" yaml:"subnetId,omitempty"`
+	UseOctavia           bool   `json:"useOctavia,omitempty" yaml:"useOctavia,omitempty"`
+}
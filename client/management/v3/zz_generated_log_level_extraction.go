@@ -0,0 +1,10 @@
+package client
+
+const (
+	LogLevelExtractionType         = "logLevelExtraction"
+	LogLevelExtractionFieldPattern = "pattern"
+)
+
+type LogLevelExtraction struct {
+	Pattern string `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+}
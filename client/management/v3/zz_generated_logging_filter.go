@@ -0,0 +1,18 @@
+package client
+
+const (
+	LoggingFilterType                 = "loggingFilter"
+	LoggingFilterFieldExclude         = "exclude"
+	LoggingFilterFieldExcludeLines    = "excludeLines"
+	LoggingFilterFieldInclude         = "include"
+	LoggingFilterFieldIncludeLines    = "includeLines"
+	LoggingFilterFieldLevelExtraction = "levelExtraction"
+)
+
+type LoggingFilter struct {
+	Exclude         *LoggingSelector    `json:"exclude,omitempty" yaml:"exclude,omitempty"`
+	ExcludeLines    []string            `json:"excludeLines,omitempty" yaml:"excludeLines,omitempty"`
+	Include         *LoggingSelector    `json:"include,omitempty" yaml:"include,omitempty"`
+	IncludeLines    []string            `json:"includeLines,omitempty" yaml:"includeLines,omitempty"`
+	LevelExtraction *LogLevelExtraction `json:"levelExtraction,omitempty" yaml:"levelExtraction,omitempty"`
+}
@@ -0,0 +1,14 @@
+package client
+
+const (
+	LoggingSelectorType                  = "loggingSelector"
+	LoggingSelectorFieldContainers       = "containers"
+	LoggingSelectorFieldNamespaces       = "namespaces"
+	LoggingSelectorFieldWorkloadSelector = "workloadSelector"
+)
+
+type LoggingSelector struct {
+	Containers       []string          `json:"containers,omitempty" yaml:"containers,omitempty"`
+	Namespaces       []string          `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
+	WorkloadSelector map[string]string `json:"workloadSelector,omitempty" yaml:"workloadSelector,omitempty"`
+}
@@ -0,0 +1,12 @@
+package client
+
+const (
+	MessageTemplatePreviewInputType                 = "messageTemplatePreviewInput"
+	MessageTemplatePreviewInputFieldMessageTemplate = "messageTemplate"
+	MessageTemplatePreviewInputFieldNotifierType    = "notifierType"
+)
+
+type MessageTemplatePreviewInput struct {
+	MessageTemplate string `json:"messageTemplate,omitempty" yaml:"messageTemplate,omitempty"`
+	NotifierType    string `json:"notifierType,omitempty" yaml:"notifierType,omitempty"`
+}
@@ -0,0 +1,10 @@
+package client
+
+const (
+	MessageTemplatePreviewOutputType         = "messageTemplatePreviewOutput"
+	MessageTemplatePreviewOutputFieldMessage = "message"
+)
+
+type MessageTemplatePreviewOutput struct {
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+}
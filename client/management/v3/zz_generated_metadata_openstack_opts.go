@@ -0,0 +1,12 @@
+package client
+
+const (
+	MetadataOpenstackOptsType                = "metadataOpenstackOpts"
+	MetadataOpenstackOptsFieldRequestTimeout = "requestTimeout"
+	MetadataOpenstackOptsFieldSearchOrder    = "searchOrder"
+)
+
+type MetadataOpenstackOpts struct {
+	RequestTimeout string `json:"requestTimeout,omitempty" yaml:"requestTimeout,omitempty"`
+	SearchOrder    string `json:"searchOrder,omitempty" yaml:"searchOrder,omitempty"`
+}
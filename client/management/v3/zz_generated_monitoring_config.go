@@ -0,0 +1,18 @@
+package client
+
+const (
+	MonitoringConfigType              = "monitoringConfig"
+	MonitoringConfigFieldNodeSelector = "nodeSelector"
+	MonitoringConfigFieldResources    = "resources"
+	MonitoringConfigFieldRetention    = "retention"
+	MonitoringConfigFieldStorageClass = "storageClass"
+	MonitoringConfigFieldStorageSize  = "storageSize"
+)
+
+type MonitoringConfig struct {
+	NodeSelector map[string]string       `json:"nodeSelector,omitempty" yaml:"nodeSelector,omitempty"`
+	Resources    *ContainerResourceLimit `json:"resources,omitempty" yaml:"resources,omitempty"`
+	Retention    string                  `json:"retention,omitempty" yaml:"retention,omitempty"`
+	StorageClass string                  `json:"storageClass,omitempty" yaml:"storageClass,omitempty"`
+	StorageSize  string                  `json:"storageSize,omitempty" yaml:"storageSize,omitempty"`
+}
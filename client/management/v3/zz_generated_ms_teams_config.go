@@ -0,0 +1,10 @@
+package client
+
+const (
+	MSTeamsConfigType     = "msTeamsConfig"
+	MSTeamsConfigFieldURL = "url"
+)
+
+type MSTeamsConfig struct {
+	URL string `json:"url,omitempty" yaml:"url,omitempty"`
+}
@@ -0,0 +1,10 @@
+package client
+
+const (
+	NetworkVsphereOptsType               = "networkVsphereOpts"
+	NetworkVsphereOptsFieldPublicNetwork = "publicNetwork"
+)
+
+type NetworkVsphereOpts struct {
+	PublicNetwork string `json:"publicNetwork,omitempty" yaml:"publicNetwork,omitempty"`
+}
@@ -8,6 +8,7 @@ const (
 	NodeType                      = "node"
 	NodeFieldAllocatable          = "allocatable"
 	NodeFieldAnnotations          = "annotations"
+	NodeFieldAppliedNodeTaints    = "appliedNodeTaints"
 	NodeFieldCapacity             = "capacity"
 	NodeFieldClusterId            = "clusterId"
 	NodeFieldConditions           = "conditions"
@@ -54,6 +55,7 @@ type Node struct {
 	types.Resource
 	Allocatable          map[string]string         `json:"allocatable,omitempty" yaml:"allocatable,omitempty"`
 	Annotations          map[string]string         `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	AppliedNodeTaints    []Taint                   `json:"appliedNodeTaints,omitempty" yaml:"appliedNodeTaints,omitempty"`
 	Capacity             map[string]string         `json:"capacity,omitempty" yaml:"capacity,omitempty"`
 	ClusterId            string                    `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
 	Conditions           []NodeCondition           `json:"conditions,omitempty" yaml:"conditions,omitempty"`
@@ -0,0 +1,20 @@
+package client
+
+const (
+	NodePlanChangeType              = "nodePlanChange"
+	NodePlanChangeFieldAction       = "action"
+	NodePlanChangeFieldAddedFiles   = "addedFiles"
+	NodePlanChangeFieldAddress      = "address"
+	NodePlanChangeFieldChangedFiles = "changedFiles"
+	NodePlanChangeFieldProcesses    = "processes"
+	NodePlanChangeFieldRemovedFiles = "removedFiles"
+)
+
+type NodePlanChange struct {
+	Action       string          `json:"action,omitempty" yaml:"action,omitempty"`
+	AddedFiles   []string        `json:"addedFiles,omitempty" yaml:"addedFiles,omitempty"`
+	Address      string          `json:"address,omitempty" yaml:"address,omitempty"`
+	ChangedFiles []string        `json:"changedFiles,omitempty" yaml:"changedFiles,omitempty"`
+	Processes    []ProcessChange `json:"processes,omitempty" yaml:"processes,omitempty"`
+	RemovedFiles []string        `json:"removedFiles,omitempty" yaml:"removedFiles,omitempty"`
+}
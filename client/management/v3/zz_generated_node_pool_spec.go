@@ -9,6 +9,7 @@ const (
 	NodePoolSpecFieldHostnamePrefix  = "hostnamePrefix"
 	NodePoolSpecFieldNodeAnnotations = "nodeAnnotations"
 	NodePoolSpecFieldNodeLabels      = "nodeLabels"
+	NodePoolSpecFieldNodeTaints      = "nodeTaints"
 	NodePoolSpecFieldNodeTemplateId  = "nodeTemplateId"
 	NodePoolSpecFieldQuantity        = "quantity"
 	NodePoolSpecFieldWorker          = "worker"
@@ -22,6 +23,7 @@ type NodePoolSpec struct {
 	HostnamePrefix  string            `json:"hostnamePrefix,omitempty" yaml:"hostnamePrefix,omitempty"`
 	NodeAnnotations map[string]string `json:"nodeAnnotations,omitempty" yaml:"nodeAnnotations,omitempty"`
 	NodeLabels      map[string]string `json:"nodeLabels,omitempty" yaml:"nodeLabels,omitempty"`
+	NodeTaints      []Taint           `json:"nodeTaints,omitempty" yaml:"nodeTaints,omitempty"`
 	NodeTemplateId  string            `json:"nodeTemplateId,omitempty" yaml:"nodeTemplateId,omitempty"`
 	Quantity        int64             `json:"quantity,omitempty" yaml:"quantity,omitempty"`
 	Worker          bool              `json:"worker,omitempty" yaml:"worker,omitempty"`
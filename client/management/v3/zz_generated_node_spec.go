@@ -7,6 +7,7 @@ const (
 	NodeSpecFieldDescription            = "description"
 	NodeSpecFieldDesiredNodeAnnotations = "desiredNodeAnnotations"
 	NodeSpecFieldDesiredNodeLabels      = "desiredNodeLabels"
+	NodeSpecFieldDesiredNodeTaints      = "desiredNodeTaints"
 	NodeSpecFieldDisplayName            = "displayName"
 	NodeSpecFieldEtcd                   = "etcd"
 	NodeSpecFieldImported               = "imported"
@@ -26,6 +27,7 @@ type NodeSpec struct {
 	Description            string            `json:"description,omitempty" yaml:"description,omitempty"`
 	DesiredNodeAnnotations map[string]string `json:"desiredNodeAnnotations,omitempty" yaml:"desiredNodeAnnotations,omitempty"`
 	DesiredNodeLabels      map[string]string `json:"desiredNodeLabels,omitempty" yaml:"desiredNodeLabels,omitempty"`
+	DesiredNodeTaints      []Taint           `json:"desiredNodeTaints,omitempty" yaml:"desiredNodeTaints,omitempty"`
 	DisplayName            string            `json:"displayName,omitempty" yaml:"displayName,omitempty"`
 	Etcd                   bool              `json:"etcd,omitempty" yaml:"etcd,omitempty"`
 	Imported               bool              `json:"imported,omitempty" yaml:"imported,omitempty"`
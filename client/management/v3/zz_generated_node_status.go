@@ -3,6 +3,7 @@ package client
 const (
 	NodeStatusType                   = "nodeStatus"
 	NodeStatusFieldAllocatable       = "allocatable"
+	NodeStatusFieldAppliedNodeTaints = "appliedNodeTaints"
 	NodeStatusFieldCapacity          = "capacity"
 	NodeStatusFieldConditions        = "conditions"
 	NodeStatusFieldDockerInfo        = "dockerInfo"
@@ -23,6 +24,7 @@ const (
 
 type NodeStatus struct {
 	Allocatable       map[string]string         `json:"allocatable,omitempty" yaml:"allocatable,omitempty"`
+	AppliedNodeTaints []Taint                   `json:"appliedNodeTaints,omitempty" yaml:"appliedNodeTaints,omitempty"`
 	Capacity          map[string]string         `json:"capacity,omitempty" yaml:"capacity,omitempty"`
 	Conditions        []NodeCondition           `json:"conditions,omitempty" yaml:"conditions,omitempty"`
 	DockerInfo        *DockerInfo               `json:"dockerInfo,omitempty" yaml:"dockerInfo,omitempty"`
@@ -0,0 +1,20 @@
+package client
+
+const (
+	NodeUpgradeProgressType                = "nodeUpgradeProgress"
+	NodeUpgradeProgressFieldAddress        = "address"
+	NodeUpgradeProgressFieldLastUpdateTime = "lastUpdateTime"
+	NodeUpgradeProgressFieldMessage        = "message"
+	NodeUpgradeProgressFieldNodeId         = "nodeId"
+	NodeUpgradeProgressFieldRole           = "role"
+	NodeUpgradeProgressFieldState          = "state"
+)
+
+type NodeUpgradeProgress struct {
+	Address        string   `json:"address,omitempty" yaml:"address,omitempty"`
+	LastUpdateTime string   `json:"lastUpdateTime,omitempty" yaml:"lastUpdateTime,omitempty"`
+	Message        string   `json:"message,omitempty" yaml:"message,omitempty"`
+	NodeId         string   `json:"nodeId,omitempty" yaml:"nodeId,omitempty"`
+	Role           []string `json:"role,omitempty" yaml:"role,omitempty"`
+	State          string   `json:"state,omitempty" yaml:"state,omitempty"`
+}
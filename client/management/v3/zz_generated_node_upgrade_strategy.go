@@ -0,0 +1,16 @@
+package client
+
+const (
+	NodeUpgradeStrategyType                = "nodeUpgradeStrategy"
+	NodeUpgradeStrategyFieldDrain          = "drain"
+	NodeUpgradeStrategyFieldDrainInput     = "nodeDrainInput"
+	NodeUpgradeStrategyFieldMaxUnavailable = "maxUnavailable"
+	NodeUpgradeStrategyFieldRoleOrder      = "roleOrder"
+)
+
+type NodeUpgradeStrategy struct {
+	Drain          bool            `json:"drain,omitempty" yaml:"drain,omitempty"`
+	DrainInput     *NodeDrainInput `json:"nodeDrainInput,omitempty" yaml:"nodeDrainInput,omitempty"`
+	MaxUnavailable string          `json:"maxUnavailable,omitempty" yaml:"maxUnavailable,omitempty"`
+	RoleOrder      []string        `json:"roleOrder,omitempty" yaml:"roleOrder,omitempty"`
+}
@@ -2,7 +2,9 @@ package client
 
 const (
 	NotificationType                 = "notification"
+	NotificationFieldMSTeamsConfig   = "msteamsConfig"
 	NotificationFieldMessage         = "message"
+	NotificationFieldOpsgenieConfig  = "opsgenieConfig"
 	NotificationFieldPagerdutyConfig = "pagerdutyConfig"
 	NotificationFieldSMTPConfig      = "smtpConfig"
 	NotificationFieldSlackConfig     = "slackConfig"
@@ -10,7 +12,9 @@ const (
 )
 
 type Notification struct {
+	MSTeamsConfig   *MSTeamsConfig   `json:"msteamsConfig,omitempty" yaml:"msteamsConfig,omitempty"`
 	Message         string           `json:"message,omitempty" yaml:"message,omitempty"`
+	OpsgenieConfig  *OpsgenieConfig  `json:"opsgenieConfig,omitempty" yaml:"opsgenieConfig,omitempty"`
 	PagerdutyConfig *PagerdutyConfig `json:"pagerdutyConfig,omitempty" yaml:"pagerdutyConfig,omitempty"`
 	SMTPConfig      *SMTPConfig      `json:"smtpConfig,omitempty" yaml:"smtpConfig,omitempty"`
 	SlackConfig     *SlackConfig     `json:"slackConfig,omitempty" yaml:"slackConfig,omitempty"`
@@ -12,8 +12,11 @@ const (
 	NotifierFieldCreatorID            = "creatorId"
 	NotifierFieldDescription          = "description"
 	NotifierFieldLabels               = "labels"
+	NotifierFieldMSTeamsConfig        = "msteamsConfig"
+	NotifierFieldMessageTemplate      = "messageTemplate"
 	NotifierFieldName                 = "name"
 	NotifierFieldNamespaceId          = "namespaceId"
+	NotifierFieldOpsgenieConfig       = "opsgenieConfig"
 	NotifierFieldOwnerReferences      = "ownerReferences"
 	NotifierFieldPagerdutyConfig      = "pagerdutyConfig"
 	NotifierFieldRemoved              = "removed"
@@ -35,8 +38,11 @@ type Notifier struct {
 	CreatorID            string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
 	Description          string            `json:"description,omitempty" yaml:"description,omitempty"`
 	Labels               map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	MSTeamsConfig        *MSTeamsConfig    `json:"msteamsConfig,omitempty" yaml:"msteamsConfig,omitempty"`
+	MessageTemplate      string            `json:"messageTemplate,omitempty" yaml:"messageTemplate,omitempty"`
 	Name                 string            `json:"name,omitempty" yaml:"name,omitempty"`
 	NamespaceId          string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OpsgenieConfig       *OpsgenieConfig   `json:"opsgenieConfig,omitempty" yaml:"opsgenieConfig,omitempty"`
 	OwnerReferences      []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
 	PagerdutyConfig      *PagerdutyConfig  `json:"pagerdutyConfig,omitempty" yaml:"pagerdutyConfig,omitempty"`
 	Removed              string            `json:"removed,omitempty" yaml:"removed,omitempty"`
@@ -66,8 +72,12 @@ type NotifierOperations interface {
 	ByID(id string) (*Notifier, error)
 	Delete(container *Notifier) error
 
+	ActionPreview(resource *Notifier, input *MessageTemplatePreviewInput) (*MessageTemplatePreviewOutput, error)
+
 	ActionSend(resource *Notifier, input *Notification) error
 
+	CollectionActionPreview(resource *NotifierCollection, input *MessageTemplatePreviewInput) (*MessageTemplatePreviewOutput, error)
+
 	CollectionActionSend(resource *NotifierCollection, input *Notification) error
 }
 
@@ -116,11 +126,23 @@ func (c *NotifierClient) Delete(container *Notifier) error {
 	return c.apiClient.Ops.DoResourceDelete(NotifierType, &container.Resource)
 }
 
+func (c *NotifierClient) ActionPreview(resource *Notifier, input *MessageTemplatePreviewInput) (*MessageTemplatePreviewOutput, error) {
+	resp := &MessageTemplatePreviewOutput{}
+	err := c.apiClient.Ops.DoAction(NotifierType, "preview", &resource.Resource, input, resp)
+	return resp, err
+}
+
 func (c *NotifierClient) ActionSend(resource *Notifier, input *Notification) error {
 	err := c.apiClient.Ops.DoAction(NotifierType, "send", &resource.Resource, input, nil)
 	return err
 }
 
+func (c *NotifierClient) CollectionActionPreview(resource *NotifierCollection, input *MessageTemplatePreviewInput) (*MessageTemplatePreviewOutput, error) {
+	resp := &MessageTemplatePreviewOutput{}
+	err := c.apiClient.Ops.DoCollectionAction(NotifierType, "preview", &resource.Collection, input, resp)
+	return resp, err
+}
+
 func (c *NotifierClient) CollectionActionSend(resource *NotifierCollection, input *Notification) error {
 	err := c.apiClient.Ops.DoCollectionAction(NotifierType, "send", &resource.Collection, input, nil)
 	return err
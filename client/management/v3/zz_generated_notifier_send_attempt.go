@@ -0,0 +1,18 @@
+package client
+
+const (
+	NotifierSendAttemptType              = "notifierSendAttempt"
+	NotifierSendAttemptFieldError        = "error"
+	NotifierSendAttemptFieldResponseCode = "responseCode"
+	NotifierSendAttemptFieldSource       = "source"
+	NotifierSendAttemptFieldSuccess      = "success"
+	NotifierSendAttemptFieldTime         = "time"
+)
+
+type NotifierSendAttempt struct {
+	Error        string `json:"error,omitempty" yaml:"error,omitempty"`
+	ResponseCode int64  `json:"responseCode,omitempty" yaml:"responseCode,omitempty"`
+	Source       string `json:"source,omitempty" yaml:"source,omitempty"`
+	Success      bool   `json:"success,omitempty" yaml:"success,omitempty"`
+	Time         string `json:"time,omitempty" yaml:"time,omitempty"`
+}
@@ -5,6 +5,9 @@ const (
 	NotifierSpecFieldClusterId       = "clusterId"
 	NotifierSpecFieldDescription     = "description"
 	NotifierSpecFieldDisplayName     = "displayName"
+	NotifierSpecFieldMSTeamsConfig   = "msteamsConfig"
+	NotifierSpecFieldMessageTemplate = "messageTemplate"
+	NotifierSpecFieldOpsgenieConfig  = "opsgenieConfig"
 	NotifierSpecFieldPagerdutyConfig = "pagerdutyConfig"
 	NotifierSpecFieldSMTPConfig      = "smtpConfig"
 	NotifierSpecFieldSlackConfig     = "slackConfig"
@@ -15,6 +18,9 @@ type NotifierSpec struct {
 	ClusterId       string           `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
 	Description     string           `json:"description,omitempty" yaml:"description,omitempty"`
 	DisplayName     string           `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	MSTeamsConfig   *MSTeamsConfig   `json:"msteamsConfig,omitempty" yaml:"msteamsConfig,omitempty"`
+	MessageTemplate string           `json:"messageTemplate,omitempty" yaml:"messageTemplate,omitempty"`
+	OpsgenieConfig  *OpsgenieConfig  `json:"opsgenieConfig,omitempty" yaml:"opsgenieConfig,omitempty"`
 	PagerdutyConfig *PagerdutyConfig `json:"pagerdutyConfig,omitempty" yaml:"pagerdutyConfig,omitempty"`
 	SMTPConfig      *SMTPConfig      `json:"smtpConfig,omitempty" yaml:"smtpConfig,omitempty"`
 	SlackConfig     *SlackConfig     `json:"slackConfig,omitempty" yaml:"slackConfig,omitempty"`
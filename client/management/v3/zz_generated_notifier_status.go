@@ -1,8 +1,14 @@
 package client
 
 const (
-	NotifierStatusType = "notifierStatus"
+	NotifierStatusType             = "notifierStatus"
+	NotifierStatusFieldConditions  = "conditions"
+	NotifierStatusFieldLastSend    = "lastSend"
+	NotifierStatusFieldSendHistory = "sendHistory"
 )
 
 type NotifierStatus struct {
+	Conditions  []Condition           `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	LastSend    *NotifierSendAttempt  `json:"lastSend,omitempty" yaml:"lastSend,omitempty"`
+	SendHistory []NotifierSendAttempt `json:"sendHistory,omitempty" yaml:"sendHistory,omitempty"`
 }
@@ -0,0 +1,14 @@
+package client
+
+const (
+	ProcessChangeType        = "processChange"
+	ProcessChangeFieldAction = "action"
+	ProcessChangeFieldFields = "fields"
+	ProcessChangeFieldName   = "name"
+)
+
+type ProcessChange struct {
+	Action string               `json:"action,omitempty" yaml:"action,omitempty"`
+	Fields []ProcessFieldChange `json:"fields,omitempty" yaml:"fields,omitempty"`
+	Name   string               `json:"name,omitempty" yaml:"name,omitempty"`
+}
@@ -0,0 +1,18 @@
+package client
+
+const (
+	ProcessFieldChangeType         = "processFieldChange"
+	ProcessFieldChangeFieldAdded   = "added"
+	ProcessFieldChangeFieldField   = "field"
+	ProcessFieldChangeFieldNew     = "new"
+	ProcessFieldChangeFieldOld     = "old"
+	ProcessFieldChangeFieldRemoved = "removed"
+)
+
+type ProcessFieldChange struct {
+	Added   []string `json:"added,omitempty" yaml:"added,omitempty"`
+	Field   string   `json:"field,omitempty" yaml:"field,omitempty"`
+	New     string   `json:"new,omitempty" yaml:"new,omitempty"`
+	Old     string   `json:"old,omitempty" yaml:"old,omitempty"`
+	Removed []string `json:"removed,omitempty" yaml:"removed,omitempty"`
+}
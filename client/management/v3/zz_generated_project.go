@@ -9,15 +9,21 @@ const (
 	ProjectFieldAnnotations                   = "annotations"
 	ProjectFieldClusterId                     = "clusterId"
 	ProjectFieldConditions                    = "conditions"
+	ProjectFieldContainerDefaultResourceLimit = "containerDefaultResourceLimit"
 	ProjectFieldCreated                       = "created"
 	ProjectFieldCreatorID                     = "creatorId"
 	ProjectFieldDescription                   = "description"
+	ProjectFieldEnableProjectMonitoring       = "enableProjectMonitoring"
 	ProjectFieldLabels                        = "labels"
+	ProjectFieldMonitoringConfig              = "monitoringConfig"
 	ProjectFieldName                          = "name"
+	ProjectFieldNamespaceDefaultResourceQuota = "namespaceDefaultResourceQuota"
 	ProjectFieldNamespaceId                   = "namespaceId"
 	ProjectFieldOwnerReferences               = "ownerReferences"
 	ProjectFieldPodSecurityPolicyTemplateName = "podSecurityPolicyTemplateId"
 	ProjectFieldRemoved                       = "removed"
+	ProjectFieldResourceQuota                 = "resourceQuota"
+	ProjectFieldResourceQuotaUsage            = "resourceQuotaUsage"
 	ProjectFieldState                         = "state"
 	ProjectFieldTransitioning                 = "transitioning"
 	ProjectFieldTransitioningMessage          = "transitioningMessage"
@@ -26,22 +32,28 @@ const (
 
 type Project struct {
 	types.Resource
-	Annotations                   map[string]string  `json:"annotations,omitempty" yaml:"annotations,omitempty"`
-	ClusterId                     string             `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
-	Conditions                    []ProjectCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
-	Created                       string             `json:"created,omitempty" yaml:"created,omitempty"`
-	CreatorID                     string             `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
-	Description                   string             `json:"description,omitempty" yaml:"description,omitempty"`
-	Labels                        map[string]string  `json:"labels,omitempty" yaml:"labels,omitempty"`
-	Name                          string             `json:"name,omitempty" yaml:"name,omitempty"`
-	NamespaceId                   string             `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
-	OwnerReferences               []OwnerReference   `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
-	PodSecurityPolicyTemplateName string             `json:"podSecurityPolicyTemplateId,omitempty" yaml:"podSecurityPolicyTemplateId,omitempty"`
-	Removed                       string             `json:"removed,omitempty" yaml:"removed,omitempty"`
-	State                         string             `json:"state,omitempty" yaml:"state,omitempty"`
-	Transitioning                 string             `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
-	TransitioningMessage          string             `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
-	Uuid                          string             `json:"uuid,omitempty" yaml:"uuid,omitempty"`
+	Annotations                   map[string]string          `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	ClusterId                     string                     `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	Conditions                    []ProjectCondition         `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	ContainerDefaultResourceLimit *ContainerResourceLimit    `json:"containerDefaultResourceLimit,omitempty" yaml:"containerDefaultResourceLimit,omitempty"`
+	Created                       string                     `json:"created,omitempty" yaml:"created,omitempty"`
+	CreatorID                     string                     `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
+	Description                   string                     `json:"description,omitempty" yaml:"description,omitempty"`
+	EnableProjectMonitoring       bool                       `json:"enableProjectMonitoring,omitempty" yaml:"enableProjectMonitoring,omitempty"`
+	Labels                        map[string]string          `json:"labels,omitempty" yaml:"labels,omitempty"`
+	MonitoringConfig              *MonitoringConfig          `json:"monitoringConfig,omitempty" yaml:"monitoringConfig,omitempty"`
+	Name                          string                     `json:"name,omitempty" yaml:"name,omitempty"`
+	NamespaceDefaultResourceQuota *NamespaceResourceQuota    `json:"namespaceDefaultResourceQuota,omitempty" yaml:"namespaceDefaultResourceQuota,omitempty"`
+	NamespaceId                   string                     `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
+	OwnerReferences               []OwnerReference           `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
+	PodSecurityPolicyTemplateName string                     `json:"podSecurityPolicyTemplateId,omitempty" yaml:"podSecurityPolicyTemplateId,omitempty"`
+	Removed                       string                     `json:"removed,omitempty" yaml:"removed,omitempty"`
+	ResourceQuota                 *ProjectResourceQuota      `json:"resourceQuota,omitempty" yaml:"resourceQuota,omitempty"`
+	ResourceQuotaUsage            *ProjectResourceQuotaUsage `json:"resourceQuotaUsage,omitempty" yaml:"resourceQuotaUsage,omitempty"`
+	State                         string                     `json:"state,omitempty" yaml:"state,omitempty"`
+	Transitioning                 string                     `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
+	TransitioningMessage          string                     `json:"transitioningMessage,omitempty" yaml:"transitioningMessage,omitempty"`
+	Uuid                          string                     `json:"uuid,omitempty" yaml:"uuid,omitempty"`
 }
 type ProjectCollection struct {
 	types.Collection
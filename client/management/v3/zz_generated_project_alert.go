@@ -11,8 +11,11 @@ const (
 	ProjectAlertFieldCreated               = "created"
 	ProjectAlertFieldCreatorID             = "creatorId"
 	ProjectAlertFieldDescription           = "description"
+	ProjectAlertFieldGroupId               = "groupId"
+	ProjectAlertFieldInhibitRules          = "inhibitRules"
 	ProjectAlertFieldInitialWaitSeconds    = "initialWaitSeconds"
 	ProjectAlertFieldLabels                = "labels"
+	ProjectAlertFieldMessageTemplate       = "messageTemplate"
 	ProjectAlertFieldName                  = "name"
 	ProjectAlertFieldNamespaceId           = "namespaceId"
 	ProjectAlertFieldOwnerReferences       = "ownerReferences"
@@ -21,7 +24,10 @@ const (
 	ProjectAlertFieldRemoved               = "removed"
 	ProjectAlertFieldRepeatIntervalSeconds = "repeatIntervalSeconds"
 	ProjectAlertFieldSeverity              = "severity"
+	ProjectAlertFieldSilenceWindows        = "silenceWindows"
 	ProjectAlertFieldState                 = "state"
+	ProjectAlertFieldSuppression           = "suppression"
+	ProjectAlertFieldTargetMetric          = "targetMetric"
 	ProjectAlertFieldTargetPod             = "targetPod"
 	ProjectAlertFieldTargetWorkload        = "targetWorkload"
 	ProjectAlertFieldTransitioning         = "transitioning"
@@ -36,8 +42,11 @@ type ProjectAlert struct {
 	Created               string            `json:"created,omitempty" yaml:"created,omitempty"`
 	CreatorID             string            `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
 	Description           string            `json:"description,omitempty" yaml:"description,omitempty"`
+	GroupId               string            `json:"groupId,omitempty" yaml:"groupId,omitempty"`
+	InhibitRules          []InhibitRule     `json:"inhibitRules,omitempty" yaml:"inhibitRules,omitempty"`
 	InitialWaitSeconds    int64             `json:"initialWaitSeconds,omitempty" yaml:"initialWaitSeconds,omitempty"`
 	Labels                map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	MessageTemplate       string            `json:"messageTemplate,omitempty" yaml:"messageTemplate,omitempty"`
 	Name                  string            `json:"name,omitempty" yaml:"name,omitempty"`
 	NamespaceId           string            `json:"namespaceId,omitempty" yaml:"namespaceId,omitempty"`
 	OwnerReferences       []OwnerReference  `json:"ownerReferences,omitempty" yaml:"ownerReferences,omitempty"`
@@ -46,7 +55,10 @@ type ProjectAlert struct {
 	Removed               string            `json:"removed,omitempty" yaml:"removed,omitempty"`
 	RepeatIntervalSeconds int64             `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
 	Severity              string            `json:"severity,omitempty" yaml:"severity,omitempty"`
+	SilenceWindows        []SilenceWindow   `json:"silenceWindows,omitempty" yaml:"silenceWindows,omitempty"`
 	State                 string            `json:"state,omitempty" yaml:"state,omitempty"`
+	Suppression           *AlertSuppression `json:"suppression,omitempty" yaml:"suppression,omitempty"`
+	TargetMetric          *MetricRule       `json:"targetMetric,omitempty" yaml:"targetMetric,omitempty"`
 	TargetPod             *TargetPod        `json:"targetPod,omitempty" yaml:"targetPod,omitempty"`
 	TargetWorkload        *TargetWorkload   `json:"targetWorkload,omitempty" yaml:"targetWorkload,omitempty"`
 	Transitioning         string            `json:"transitioning,omitempty" yaml:"transitioning,omitempty"`
@@ -0,0 +1,22 @@
+package client
+
+const (
+	ProjectAlertGroupSpecType                       = "projectAlertGroupSpec"
+	ProjectAlertGroupSpecFieldDescription           = "description"
+	ProjectAlertGroupSpecFieldDisplayName           = "displayName"
+	ProjectAlertGroupSpecFieldGroupBy               = "groupBy"
+	ProjectAlertGroupSpecFieldGroupIntervalSeconds  = "groupIntervalSeconds"
+	ProjectAlertGroupSpecFieldGroupWaitSeconds      = "groupWaitSeconds"
+	ProjectAlertGroupSpecFieldProjectId             = "projectId"
+	ProjectAlertGroupSpecFieldRepeatIntervalSeconds = "repeatIntervalSeconds"
+)
+
+type ProjectAlertGroupSpec struct {
+	Description           string   `json:"description,omitempty" yaml:"description,omitempty"`
+	DisplayName           string   `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	GroupBy               []string `json:"groupBy,omitempty" yaml:"groupBy,omitempty"`
+	GroupIntervalSeconds  int64    `json:"groupIntervalSeconds,omitempty" yaml:"groupIntervalSeconds,omitempty"`
+	GroupWaitSeconds      int64    `json:"groupWaitSeconds,omitempty" yaml:"groupWaitSeconds,omitempty"`
+	ProjectId             string   `json:"projectId,omitempty" yaml:"projectId,omitempty"`
+	RepeatIntervalSeconds int64    `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
+}
@@ -4,11 +4,16 @@ const (
 	ProjectAlertSpecType                       = "projectAlertSpec"
 	ProjectAlertSpecFieldDescription           = "description"
 	ProjectAlertSpecFieldDisplayName           = "displayName"
+	ProjectAlertSpecFieldGroupId               = "groupId"
+	ProjectAlertSpecFieldInhibitRules          = "inhibitRules"
 	ProjectAlertSpecFieldInitialWaitSeconds    = "initialWaitSeconds"
+	ProjectAlertSpecFieldMessageTemplate       = "messageTemplate"
 	ProjectAlertSpecFieldProjectId             = "projectId"
 	ProjectAlertSpecFieldRecipients            = "recipients"
 	ProjectAlertSpecFieldRepeatIntervalSeconds = "repeatIntervalSeconds"
 	ProjectAlertSpecFieldSeverity              = "severity"
+	ProjectAlertSpecFieldSilenceWindows        = "silenceWindows"
+	ProjectAlertSpecFieldTargetMetric          = "targetMetric"
 	ProjectAlertSpecFieldTargetPod             = "targetPod"
 	ProjectAlertSpecFieldTargetWorkload        = "targetWorkload"
 )
@@ -16,11 +21,16 @@ const (
 type ProjectAlertSpec struct {
 	Description           string          `json:"description,omitempty" yaml:"description,omitempty"`
 	DisplayName           string          `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	GroupId               string          `json:"groupId,omitempty" yaml:"groupId,omitempty"`
+	InhibitRules          []InhibitRule   `json:"inhibitRules,omitempty" yaml:"inhibitRules,omitempty"`
 	InitialWaitSeconds    int64           `json:"initialWaitSeconds,omitempty" yaml:"initialWaitSeconds,omitempty"`
+	MessageTemplate       string          `json:"messageTemplate,omitempty" yaml:"messageTemplate,omitempty"`
 	ProjectId             string          `json:"projectId,omitempty" yaml:"projectId,omitempty"`
 	Recipients            []Recipient     `json:"recipients,omitempty" yaml:"recipients,omitempty"`
 	RepeatIntervalSeconds int64           `json:"repeatIntervalSeconds,omitempty" yaml:"repeatIntervalSeconds,omitempty"`
 	Severity              string          `json:"severity,omitempty" yaml:"severity,omitempty"`
+	SilenceWindows        []SilenceWindow `json:"silenceWindows,omitempty" yaml:"silenceWindows,omitempty"`
+	TargetMetric          *MetricRule     `json:"targetMetric,omitempty" yaml:"targetMetric,omitempty"`
 	TargetPod             *TargetPod      `json:"targetPod,omitempty" yaml:"targetPod,omitempty"`
 	TargetWorkload        *TargetWorkload `json:"targetWorkload,omitempty" yaml:"targetWorkload,omitempty"`
 }
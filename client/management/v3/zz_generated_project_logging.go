@@ -10,6 +10,7 @@ const (
 	ProjectLoggingFieldCreated              = "created"
 	ProjectLoggingFieldCreatorID            = "creatorId"
 	ProjectLoggingFieldElasticsearchConfig  = "elasticsearchConfig"
+	ProjectLoggingFieldFilter               = "filter"
 	ProjectLoggingFieldKafkaConfig          = "kafkaConfig"
 	ProjectLoggingFieldLabels               = "labels"
 	ProjectLoggingFieldName                 = "name"
@@ -34,6 +35,7 @@ type ProjectLogging struct {
 	Created              string                `json:"created,omitempty" yaml:"created,omitempty"`
 	CreatorID            string                `json:"creatorId,omitempty" yaml:"creatorId,omitempty"`
 	ElasticsearchConfig  *ElasticsearchConfig  `json:"elasticsearchConfig,omitempty" yaml:"elasticsearchConfig,omitempty"`
+	Filter               *LoggingFilter        `json:"filter,omitempty" yaml:"filter,omitempty"`
 	KafkaConfig          *KafkaConfig          `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
 	Labels               map[string]string     `json:"labels,omitempty" yaml:"labels,omitempty"`
 	Name                 string                `json:"name,omitempty" yaml:"name,omitempty"`
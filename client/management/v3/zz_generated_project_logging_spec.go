@@ -4,6 +4,7 @@ const (
 	ProjectLoggingSpecType                     = "projectLoggingSpec"
 	ProjectLoggingSpecFieldDisplayName         = "displayName"
 	ProjectLoggingSpecFieldElasticsearchConfig = "elasticsearchConfig"
+	ProjectLoggingSpecFieldFilter              = "filter"
 	ProjectLoggingSpecFieldKafkaConfig         = "kafkaConfig"
 	ProjectLoggingSpecFieldOutputFlushInterval = "outputFlushInterval"
 	ProjectLoggingSpecFieldOutputTags          = "outputTags"
@@ -15,6 +16,7 @@ const (
 type ProjectLoggingSpec struct {
 	DisplayName         string               `json:"displayName,omitempty" yaml:"displayName,omitempty"`
 	ElasticsearchConfig *ElasticsearchConfig `json:"elasticsearchConfig,omitempty" yaml:"elasticsearchConfig,omitempty"`
+	Filter              *LoggingFilter       `json:"filter,omitempty" yaml:"filter,omitempty"`
 	KafkaConfig         *KafkaConfig         `json:"kafkaConfig,omitempty" yaml:"kafkaConfig,omitempty"`
 	OutputFlushInterval int64                `json:"outputFlushInterval,omitempty" yaml:"outputFlushInterval,omitempty"`
 	OutputTags          map[string]string    `json:"outputTags,omitempty" yaml:"outputTags,omitempty"`
@@ -0,0 +1,10 @@
+package client
+
+const (
+	ProjectResourceQuotaType       = "projectResourceQuota"
+	ProjectResourceQuotaFieldLimit = "limit"
+)
+
+type ProjectResourceQuota struct {
+	Limit *ResourceQuotaLimit `json:"limit,omitempty" yaml:"limit,omitempty"`
+}
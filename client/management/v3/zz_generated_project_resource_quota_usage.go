@@ -0,0 +1,12 @@
+package client
+
+const (
+	ProjectResourceQuotaUsageType           = "projectResourceQuotaUsage"
+	ProjectResourceQuotaUsageFieldLimit     = "limit"
+	ProjectResourceQuotaUsageFieldUsedLimit = "usedLimit"
+)
+
+type ProjectResourceQuotaUsage struct {
+	Limit     *ResourceQuotaLimit `json:"limit,omitempty" yaml:"limit,omitempty"`
+	UsedLimit *ResourceQuotaLimit `json:"usedLimit,omitempty" yaml:"usedLimit,omitempty"`
+}
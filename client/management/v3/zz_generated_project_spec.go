@@ -1,14 +1,24 @@
 package client
 
 const (
-	ProjectSpecType             = "projectSpec"
-	ProjectSpecFieldClusterId   = "clusterId"
-	ProjectSpecFieldDescription = "description"
-	ProjectSpecFieldDisplayName = "displayName"
+	ProjectSpecType                               = "projectSpec"
+	ProjectSpecFieldClusterId                     = "clusterId"
+	ProjectSpecFieldContainerDefaultResourceLimit = "containerDefaultResourceLimit"
+	ProjectSpecFieldDescription                   = "description"
+	ProjectSpecFieldDisplayName                   = "displayName"
+	ProjectSpecFieldEnableProjectMonitoring       = "enableProjectMonitoring"
+	ProjectSpecFieldMonitoringConfig              = "monitoringConfig"
+	ProjectSpecFieldNamespaceDefaultResourceQuota = "namespaceDefaultResourceQuota"
+	ProjectSpecFieldResourceQuota                 = "resourceQuota"
 )
 
 type ProjectSpec struct {
-	ClusterId   string `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
-	Description string `json:"description,omitempty" yaml:"description,omitempty"`
-	DisplayName string `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	ClusterId                     string                  `json:"clusterId,omitempty" yaml:"clusterId,omitempty"`
+	ContainerDefaultResourceLimit *ContainerResourceLimit `json:"containerDefaultResourceLimit,omitempty" yaml:"containerDefaultResourceLimit,omitempty"`
+	Description                   string                  `json:"description,omitempty" yaml:"description,omitempty"`
+	DisplayName                   string                  `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+	EnableProjectMonitoring       bool                    `json:"enableProjectMonitoring,omitempty" yaml:"enableProjectMonitoring,omitempty"`
+	MonitoringConfig              *MonitoringConfig       `json:"monitoringConfig,omitempty" yaml:"monitoringConfig,omitempty"`
+	NamespaceDefaultResourceQuota *NamespaceResourceQuota `json:"namespaceDefaultResourceQuota,omitempty" yaml:"namespaceDefaultResourceQuota,omitempty"`
+	ResourceQuota                 *ProjectResourceQuota   `json:"resourceQuota,omitempty" yaml:"resourceQuota,omitempty"`
 }
@@ -4,9 +4,11 @@ const (
 	ProjectStatusType                               = "projectStatus"
 	ProjectStatusFieldConditions                    = "conditions"
 	ProjectStatusFieldPodSecurityPolicyTemplateName = "podSecurityPolicyTemplateId"
+	ProjectStatusFieldResourceQuotaUsage            = "resourceQuotaUsage"
 )
 
 type ProjectStatus struct {
-	Conditions                    []ProjectCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
-	PodSecurityPolicyTemplateName string             `json:"podSecurityPolicyTemplateId,omitempty" yaml:"podSecurityPolicyTemplateId,omitempty"`
+	Conditions                    []ProjectCondition         `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	PodSecurityPolicyTemplateName string                     `json:"podSecurityPolicyTemplateId,omitempty" yaml:"podSecurityPolicyTemplateId,omitempty"`
+	ResourceQuotaUsage            *ProjectResourceQuotaUsage `json:"resourceQuotaUsage,omitempty" yaml:"resourceQuotaUsage,omitempty"`
 }
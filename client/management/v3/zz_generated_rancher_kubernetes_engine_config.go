@@ -10,6 +10,7 @@ const (
 	RancherKubernetesEngineConfigFieldBastionHost         = "bastionHost"
 	RancherKubernetesEngineConfigFieldCloudProvider       = "cloudProvider"
 	RancherKubernetesEngineConfigFieldClusterName         = "clusterName"
+	RancherKubernetesEngineConfigFieldDNS                 = "dns"
 	RancherKubernetesEngineConfigFieldIgnoreDockerVersion = "ignoreDockerVersion"
 	RancherKubernetesEngineConfigFieldIngress             = "ingress"
 	RancherKubernetesEngineConfigFieldNetwork             = "network"
@@ -19,26 +20,29 @@ const (
 	RancherKubernetesEngineConfigFieldSSHAgentAuth        = "sshAgentAuth"
 	RancherKubernetesEngineConfigFieldSSHKeyPath          = "sshKeyPath"
 	RancherKubernetesEngineConfigFieldServices            = "services"
+	RancherKubernetesEngineConfigFieldUpgradeStrategy     = "upgradeStrategy"
 	RancherKubernetesEngineConfigFieldVersion             = "kubernetesVersion"
 )
 
 type RancherKubernetesEngineConfig struct {
-	AddonJobTimeout     int64              `json:"addonJobTimeout,omitempty" yaml:"addonJobTimeout,omitempty"`
-	Addons              string             `json:"addons,omitempty" yaml:"addons,omitempty"`
-	AddonsInclude       []string           `json:"addonsInclude,omitempty" yaml:"addonsInclude,omitempty"`
-	Authentication      *AuthnConfig       `json:"authentication,omitempty" yaml:"authentication,omitempty"`
-	Authorization       *AuthzConfig       `json:"authorization,omitempty" yaml:"authorization,omitempty"`
-	BastionHost         *BastionHost       `json:"bastionHost,omitempty" yaml:"bastionHost,omitempty"`
-	CloudProvider       *CloudProvider     `json:"cloudProvider,omitempty" yaml:"cloudProvider,omitempty"`
-	ClusterName         string             `json:"clusterName,omitempty" yaml:"clusterName,omitempty"`
-	IgnoreDockerVersion bool               `json:"ignoreDockerVersion,omitempty" yaml:"ignoreDockerVersion,omitempty"`
-	Ingress             *IngressConfig     `json:"ingress,omitempty" yaml:"ingress,omitempty"`
-	Network             *NetworkConfig     `json:"network,omitempty" yaml:"network,omitempty"`
-	Nodes               []RKEConfigNode    `json:"nodes,omitempty" yaml:"nodes,omitempty"`
-	PrefixPath          string             `json:"prefixPath,omitempty" yaml:"prefixPath,omitempty"`
-	PrivateRegistries   []PrivateRegistry  `json:"privateRegistries,omitempty" yaml:"privateRegistries,omitempty"`
-	SSHAgentAuth        bool               `json:"sshAgentAuth,omitempty" yaml:"sshAgentAuth,omitempty"`
-	SSHKeyPath          string             `json:"sshKeyPath,omitempty" yaml:"sshKeyPath,omitempty"`
-	Services            *RKEConfigServices `json:"services,omitempty" yaml:"services,omitempty"`
-	Version             string             `json:"kubernetesVersion,omitempty" yaml:"kubernetesVersion,omitempty"`
+	AddonJobTimeout     int64                `json:"addonJobTimeout,omitempty" yaml:"addonJobTimeout,omitempty"`
+	Addons              string               `json:"addons,omitempty" yaml:"addons,omitempty"`
+	AddonsInclude       []string             `json:"addonsInclude,omitempty" yaml:"addonsInclude,omitempty"`
+	Authentication      *AuthnConfig         `json:"authentication,omitempty" yaml:"authentication,omitempty"`
+	Authorization       *AuthzConfig         `json:"authorization,omitempty" yaml:"authorization,omitempty"`
+	BastionHost         *BastionHost         `json:"bastionHost,omitempty" yaml:"bastionHost,omitempty"`
+	CloudProvider       *CloudProvider       `json:"cloudProvider,omitempty" yaml:"cloudProvider,omitempty"`
+	ClusterName         string               `json:"clusterName,omitempty" yaml:"clusterName,omitempty"`
+	DNS                 *DNSConfig           `json:"dns,omitempty" yaml:"dns,omitempty"`
+	IgnoreDockerVersion bool                 `json:"ignoreDockerVersion,omitempty" yaml:"ignoreDockerVersion,omitempty"`
+	Ingress             *IngressConfig       `json:"ingress,omitempty" yaml:"ingress,omitempty"`
+	Network             *NetworkConfig       `json:"network,omitempty" yaml:"network,omitempty"`
+	Nodes               []RKEConfigNode      `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+	PrefixPath          string               `json:"prefixPath,omitempty" yaml:"prefixPath,omitempty"`
+	PrivateRegistries   []PrivateRegistry    `json:"privateRegistries,omitempty" yaml:"privateRegistries,omitempty"`
+	SSHAgentAuth        bool                 `json:"sshAgentAuth,omitempty" yaml:"sshAgentAuth,omitempty"`
+	SSHKeyPath          string               `json:"sshKeyPath,omitempty" yaml:"sshKeyPath,omitempty"`
+	Services            *RKEConfigServices   `json:"services,omitempty" yaml:"services,omitempty"`
+	UpgradeStrategy     *NodeUpgradeStrategy `json:"upgradeStrategy,omitempty" yaml:"upgradeStrategy,omitempty"`
+	Version             string               `json:"kubernetesVersion,omitempty" yaml:"kubernetesVersion,omitempty"`
 }
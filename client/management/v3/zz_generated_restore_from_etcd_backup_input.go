@@ -0,0 +1,10 @@
+package client
+
+const (
+	RestoreFromEtcdBackupInputType              = "restoreFromEtcdBackupInput"
+	RestoreFromEtcdBackupInputFieldEtcdBackupId = "etcdBackupId"
+)
+
+type RestoreFromEtcdBackupInput struct {
+	EtcdBackupId string `json:"etcdBackupId,omitempty" yaml:"etcdBackupId,omitempty"`
+}
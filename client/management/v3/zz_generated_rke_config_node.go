@@ -13,6 +13,8 @@ const (
 	RKEConfigNodeFieldSSHAgentAuth     = "sshAgentAuth"
 	RKEConfigNodeFieldSSHKey           = "sshKey"
 	RKEConfigNodeFieldSSHKeyPath       = "sshKeyPath"
+	RKEConfigNodeFieldSSHKeySecretRef  = "sshKeySecretRef"
+	RKEConfigNodeFieldTaints           = "taints"
 	RKEConfigNodeFieldUser             = "user"
 )
 
@@ -28,5 +30,7 @@ type RKEConfigNode struct {
 	SSHAgentAuth     bool              `json:"sshAgentAuth,omitempty" yaml:"sshAgentAuth,omitempty"`
 	SSHKey           string            `json:"sshKey,omitempty" yaml:"sshKey,omitempty"`
 	SSHKeyPath       string            `json:"sshKeyPath,omitempty" yaml:"sshKeyPath,omitempty"`
+	SSHKeySecretRef  *SecretKeyRef     `json:"sshKeySecretRef,omitempty" yaml:"sshKeySecretRef,omitempty"`
+	Taints           []Taint           `json:"taints,omitempty" yaml:"taints,omitempty"`
 	User             string            `json:"user,omitempty" yaml:"user,omitempty"`
 }
@@ -0,0 +1,10 @@
+package client
+
+const (
+	RouteOpenstackOptsType          = "routeOpenstackOpts"
+	RouteOpenstackOptsFieldRouterID = "routerId"
+)
+
+type RouteOpenstackOpts struct {
+	RouterID string `json:"routerId,omitempty" yaml:"routerId,omitempty"`
+}
@@ -0,0 +1,18 @@
+package client
+
+const (
+	S3BackupConfigType                  = "s3BackupConfig"
+	S3BackupConfigFieldBucketName       = "bucketName"
+	S3BackupConfigFieldCredentialSecret = "credentialSecret"
+	S3BackupConfigFieldEndpoint         = "endpoint"
+	S3BackupConfigFieldFolder           = "folder"
+	S3BackupConfigFieldRegion           = "region"
+)
+
+type S3BackupConfig struct {
+	BucketName       string           `json:"bucketName,omitempty" yaml:"bucketName,omitempty"`
+	CredentialSecret *SecretReference `json:"credentialSecret,omitempty" yaml:"credentialSecret,omitempty"`
+	Endpoint         string           `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Folder           string           `json:"folder,omitempty" yaml:"folder,omitempty"`
+	Region           string           `json:"region,omitempty" yaml:"region,omitempty"`
+}
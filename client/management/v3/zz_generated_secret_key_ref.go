@@ -0,0 +1,14 @@
+package client
+
+const (
+	SecretKeyRefType           = "secretKeyRef"
+	SecretKeyRefFieldKey       = "key"
+	SecretKeyRefFieldName      = "name"
+	SecretKeyRefFieldNamespace = "namespace"
+)
+
+type SecretKeyRef struct {
+	Key       string `json:"key,omitempty" yaml:"key,omitempty"`
+	Name      string `json:"name,omitempty" yaml:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+}
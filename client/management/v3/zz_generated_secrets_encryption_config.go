@@ -0,0 +1,14 @@
+package client
+
+const (
+	SecretsEncryptionConfigType             = "secretsEncryptionConfig"
+	SecretsEncryptionConfigFieldEnabled     = "enabled"
+	SecretsEncryptionConfigFieldKeyRotation = "keyRotation"
+	SecretsEncryptionConfigFieldProviders   = "providers"
+)
+
+type SecretsEncryptionConfig struct {
+	Enabled     bool                 `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	KeyRotation *KeyRotation         `json:"keyRotation,omitempty" yaml:"keyRotation,omitempty"`
+	Providers   []EncryptionProvider `json:"providers,omitempty" yaml:"providers,omitempty"`
+}
@@ -0,0 +1,20 @@
+package client
+
+const (
+	ServiceOverrideType               = "serviceOverride"
+	ServiceOverrideFieldRegion        = "region"
+	ServiceOverrideFieldService       = "service"
+	ServiceOverrideFieldSigningMethod = "signingMethod"
+	ServiceOverrideFieldSigningName   = "signingName"
+	ServiceOverrideFieldSigningRegion = "signingRegion"
+	ServiceOverrideFieldURL           = "url"
+)
+
+type ServiceOverride struct {
+	Region        string `json:"region,omitempty" yaml:"region,omitempty"`
+	Service       string `json:"service,omitempty" yaml:"service,omitempty"`
+	SigningMethod string `json:"signingMethod,omitempty" yaml:"signingMethod,omitempty"`
+	SigningName   string `json:"signingName,omitempty" yaml:"signingName,omitempty"`
+	SigningRegion string `json:"signingRegion,omitempty" yaml:"signingRegion,omitempty"`
+	URL           string `json:"url,omitempty" yaml:"url,omitempty"`
+}
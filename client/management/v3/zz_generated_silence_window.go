@@ -0,0 +1,18 @@
+package client
+
+const (
+	SilenceWindowType            = "silenceWindow"
+	SilenceWindowFieldComment    = "comment"
+	SilenceWindowFieldEnd        = "end"
+	SilenceWindowFieldRecurrence = "recurrence"
+	SilenceWindowFieldStart      = "start"
+	SilenceWindowFieldUntil      = "until"
+)
+
+type SilenceWindow struct {
+	Comment    string `json:"comment,omitempty" yaml:"comment,omitempty"`
+	End        string `json:"end,omitempty" yaml:"end,omitempty"`
+	Recurrence string `json:"recurrence,omitempty" yaml:"recurrence,omitempty"`
+	Start      string `json:"start,omitempty" yaml:"start,omitempty"`
+	Until      string `json:"until,omitempty" yaml:"until,omitempty"`
+}
@@ -0,0 +1,20 @@
+package client
+
+const (
+	VirtualCenterConfigType                   = "virtualCenterConfig"
+	VirtualCenterConfigFieldDatacenters       = "datacenters"
+	VirtualCenterConfigFieldPassword          = "password"
+	VirtualCenterConfigFieldPasswordSecretRef = "passwordSecretRef"
+	VirtualCenterConfigFieldRoundTripperCount = "soapRoundtripCount"
+	VirtualCenterConfigFieldUser              = "user"
+	VirtualCenterConfigFieldVCenterPort       = "port"
+)
+
+type VirtualCenterConfig struct {
+	Datacenters       string        `json:"datacenters,omitempty" yaml:"datacenters,omitempty"`
+	Password          string        `json:"password,omitempty" yaml:"password,omitempty"`
+	PasswordSecretRef *SecretKeyRef `json:"passwordSecretRef,omitempty" yaml:"passwordSecretRef,omitempty"`
+	RoundTripperCount int64         `json:"soapRoundtripCount,omitempty" yaml:"soapRoundtripCount,omitempty"`
+	User              string        `json:"user,omitempty" yaml:"user,omitempty"`
+	VCenterPort       string        `json:"port,omitempty" yaml:"port,omitempty"`
+}
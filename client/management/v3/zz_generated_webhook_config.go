@@ -1,10 +1,20 @@
 package client
 
 const (
-	WebhookConfigType     = "webhookConfig"
-	WebhookConfigFieldURL = "url"
+	WebhookConfigType              = "webhookConfig"
+	WebhookConfigFieldBasicAuth    = "basicAuth"
+	WebhookConfigFieldBearerToken  = "bearerToken"
+	WebhookConfigFieldBodyTemplate = "bodyTemplate"
+	WebhookConfigFieldHeaders      = "headers"
+	WebhookConfigFieldTLSConfig    = "tlsConfig"
+	WebhookConfigFieldURL          = "url"
 )
 
 type WebhookConfig struct {
-	URL string `json:"url,omitempty" yaml:"url,omitempty"`
+	BasicAuth    *HTTPBasicAuth    `json:"basicAuth,omitempty" yaml:"basicAuth,omitempty"`
+	BearerToken  string            `json:"bearerToken,omitempty" yaml:"bearerToken,omitempty"`
+	BodyTemplate string            `json:"bodyTemplate,omitempty" yaml:"bodyTemplate,omitempty"`
+	Headers      map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	TLSConfig    *HTTPTLSConfig    `json:"tlsConfig,omitempty" yaml:"tlsConfig,omitempty"`
+	URL          string            `json:"url,omitempty" yaml:"url,omitempty"`
 }
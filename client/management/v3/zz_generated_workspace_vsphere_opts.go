@@ -0,0 +1,18 @@
+package client
+
+const (
+	WorkspaceVsphereOptsType                  = "workspaceVsphereOpts"
+	WorkspaceVsphereOptsFieldDatacenter       = "datacenter"
+	WorkspaceVsphereOptsFieldDefaultDatastore = "defaultDatastore"
+	WorkspaceVsphereOptsFieldFolder           = "folder"
+	WorkspaceVsphereOptsFieldResourcePoolPath = "resourcepoolPath"
+	WorkspaceVsphereOptsFieldVCenterIP        = "server"
+)
+
+type WorkspaceVsphereOpts struct {
+	Datacenter       string `json:"datacenter,omitempty" yaml:"datacenter,omitempty"`
+	DefaultDatastore string `json:"defaultDatastore,omitempty" yaml:"defaultDatastore,omitempty"`
+	Folder           string `json:"folder,omitempty" yaml:"folder,omitempty"`
+	ResourcePoolPath string `json:"resourcepoolPath,omitempty" yaml:"resourcepoolPath,omitempty"`
+	VCenterIP        string `json:"server,omitempty" yaml:"server,omitempty"`
+}
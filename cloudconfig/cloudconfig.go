@@ -0,0 +1,168 @@
+package cloudconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+// ConfigFile is the path the cloud config is written to on every node
+const ConfigFile = "/etc/kubernetes/cloud-config"
+
+// Render returns the cloud config of the provider in the format the kubernetes cloud provider reads, azure
+// uses json and all other typed providers use ini. An untyped provider renders CloudConfig as json. The
+// result is empty if the provider needs no cloud config.
+func Render(cp v3.CloudProvider) (string, error) {
+	switch {
+	case cp.AWSCloudProvider != nil:
+		return INI(cp.AWSCloudProvider)
+	case cp.AzureCloudProvider != nil:
+		return indentedJSON(cp.AzureCloudProvider)
+	case cp.VsphereCloudProvider != nil:
+		return INI(cp.VsphereCloudProvider)
+	case cp.OpenstackCloudProvider != nil:
+		return INI(cp.OpenstackCloudProvider)
+	case len(cp.CloudConfig) > 0:
+		return indentedJSON(cp.CloudConfig)
+	}
+	return "", nil
+}
+
+// File returns the cloud config of the provider as a file of the node plan, nil if the provider needs no
+// cloud config
+func File(cp v3.CloudProvider) (*v3.File, error) {
+	contents, err := Render(cp)
+	if err != nil || contents == "" {
+		return nil, err
+	}
+	return &v3.File{
+		Name:     ConfigFile,
+		Contents: contents,
+	}, nil
+}
+
+// Args returns the arguments that enable the provider on the kubelet, kube-apiserver and
+// kube-controller-manager. The cloud config is only passed if the provider has one, see File.
+func Args(cp v3.CloudProvider) (map[string]string, error) {
+	if cp.Name == "" {
+		return nil, nil
+	}
+	args := map[string]string{
+		"cloud-provider": cp.Name,
+	}
+	file, err := File(cp)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		args["cloud-config"] = ConfigFile
+	}
+	return args, nil
+}
+
+// INI renders a struct in the gcfg format of the kubernetes cloud providers. Every field with an ini tag
+// is a section, struct fields become a section and maps of structs become one subsection per key in
+// sorted order. Empty values and sections are left out and all strings are quoted.
+func INI(config interface{}) (string, error) {
+	v := reflect.Indirect(reflect.ValueOf(config))
+	if v.Kind() != reflect.Struct {
+		return "", fmt.Errorf("cloud config must be a struct, not %s", v.Kind())
+	}
+
+	buf := &bytes.Buffer{}
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Tag.Get("ini")
+		if name == "" {
+			continue
+		}
+
+		field := v.Field(i)
+		switch field.Kind() {
+		case reflect.Struct:
+			if err := writeSection(buf, fmt.Sprintf("[%s]", name), field); err != nil {
+				return "", err
+			}
+		case reflect.Map:
+			var keys []string
+			for _, key := range field.MapKeys() {
+				keys = append(keys, key.String())
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				header := fmt.Sprintf("[%s %s]", name, quote(key))
+				if err := writeSection(buf, header, field.MapIndex(reflect.ValueOf(key))); err != nil {
+					return "", err
+				}
+			}
+		default:
+			return "", fmt.Errorf("section %s must be a struct or a map, not %s", name, field.Kind())
+		}
+	}
+
+	return buf.String(), nil
+}
+
+func writeSection(buf *bytes.Buffer, header string, section reflect.Value) error {
+	var lines []string
+	for i := 0; i < section.NumField(); i++ {
+		name := section.Type().Field(i).Tag.Get("ini")
+		if name == "" {
+			continue
+		}
+
+		field := section.Field(i)
+		var value string
+		switch field.Kind() {
+		case reflect.String:
+			if field.String() == "" {
+				continue
+			}
+			value = quote(field.String())
+		case reflect.Bool:
+			if !field.Bool() {
+				continue
+			}
+			value = "true"
+		case reflect.Int, reflect.Int32, reflect.Int64:
+			if field.Int() == 0 {
+				continue
+			}
+			value = strconv.FormatInt(field.Int(), 10)
+		default:
+			return fmt.Errorf("unsupported type %s of %s %s", field.Kind(), header, name)
+		}
+		lines = append(lines, fmt.Sprintf("%s = %s\n", name, value))
+	}
+
+	if len(lines) == 0 {
+		return nil
+	}
+	if buf.Len() > 0 {
+		buf.WriteString("\n")
+	}
+	buf.WriteString(header + "\n")
+	for _, line := range lines {
+		buf.WriteString(line)
+	}
+	return nil
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`)
+
+func quote(value string) string {
+	return `"` + escaper.Replace(value) + `"`
+}
+
+func indentedJSON(config interface{}) (string, error) {
+	content, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(content) + "\n", nil
+}
@@ -0,0 +1,89 @@
+package cloudconfig
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"github.com/rancher/types/internal/golden"
+)
+
+func TestRender(t *testing.T) {
+	golden.Run(t, func() interface{} {
+		return &v3.CloudProvider{}
+	}, func(input interface{}) (string, error) {
+		return Render(*input.(*v3.CloudProvider))
+	})
+}
+
+func TestINIRejectsUnsupportedTypes(t *testing.T) {
+	tests := []struct {
+		name   string
+		config interface{}
+	}{
+		{
+			name:   "not a struct",
+			config: "[Global]",
+		},
+		{
+			name: "section of a string",
+			config: struct {
+				Global string `ini:"Global"`
+			}{},
+		},
+		{
+			name: "slice value",
+			config: struct {
+				Global struct {
+					Zones []string `ini:"zones"`
+				} `ini:"Global"`
+			}{},
+		},
+	}
+
+	for _, test := range tests {
+		if _, err := INI(test.config); err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+	}
+}
+
+func TestArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider v3.CloudProvider
+		expected map[string]string
+	}{
+		{
+			name: "no provider",
+		},
+		{
+			name:     "empty aws config",
+			provider: v3.CloudProvider{Name: "aws", AWSCloudProvider: &v3.AWSCloudProvider{}},
+			expected: map[string]string{"cloud-provider": "aws"},
+		},
+		{
+			name: "aws config",
+			provider: v3.CloudProvider{Name: "aws", AWSCloudProvider: &v3.AWSCloudProvider{
+				Global: v3.GlobalAwsOpts{Zone: "us-east-1a"},
+			}},
+			expected: map[string]string{"cloud-provider": "aws", "cloud-config": ConfigFile},
+		},
+		{
+			name:     "untyped config",
+			provider: v3.CloudProvider{Name: "external", CloudConfig: map[string]string{"key": "value"}},
+			expected: map[string]string{"cloud-provider": "external", "cloud-config": ConfigFile},
+		},
+	}
+
+	for _, test := range tests {
+		args, err := Args(test.provider)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(args, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, args)
+		}
+	}
+}
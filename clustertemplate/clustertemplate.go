@@ -0,0 +1,372 @@
+package clustertemplate
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+// TemplatedFields are the fields of the cluster spec a revision controls, all other fields are set per cluster
+var TemplatedFields = []string{
+	"rancherKubernetesEngineConfig",
+	"defaultPodSecurityPolicyTemplateName",
+	"defaultClusterRoleForProjectMembers",
+}
+
+// ValidateRevision checks that every question of the revision refers to a templated field of the cluster
+// spec and that its default can be assigned to the field
+func ValidateRevision(revision *v3.ClusterTemplateRevision) error {
+	if revision.Spec.ClusterConfig == nil {
+		return fmt.Errorf("revision [%s] has no cluster config", revision.Name)
+	}
+
+	seen := map[string]bool{}
+	for _, question := range revision.Spec.Questions {
+		if seen[question.Variable] {
+			return fmt.Errorf("question [%s] is defined more than once", question.Variable)
+		}
+		seen[question.Variable] = true
+
+		field, err := templatedField(question.Variable)
+		if err != nil {
+			return err
+		}
+		if !answerable(field) {
+			return fmt.Errorf("[%s] can not be a question, only strings, numbers, booleans and lists of strings can be answered", question.Variable)
+		}
+		if question.Default != "" {
+			if _, err := convertAnswer(question, field, question.Default); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Resolve returns the cluster spec of the revision with the answers applied. Questions without an answer
+// keep their default, or the value of the cluster config of the revision if they have none, while an empty
+// answer sets the field to its empty value. The result refers to the revision and holds the answers, the
+// fields that are set per cluster are empty. Disabled revisions can not be resolved.
+func Resolve(revision *v3.ClusterTemplateRevision, answers map[string]string) (*v3.ClusterSpec, error) {
+	if err := checkEnabled(revision); err != nil {
+		return nil, err
+	}
+	return resolve(revision, answers)
+}
+
+func resolve(revision *v3.ClusterTemplateRevision, answers map[string]string) (*v3.ClusterSpec, error) {
+	if err := ValidateRevision(revision); err != nil {
+		return nil, err
+	}
+
+	questions := map[string]v3.Question{}
+	for _, question := range revision.Spec.Questions {
+		questions[question.Variable] = question
+	}
+	for variable := range answers {
+		if _, ok := questions[variable]; !ok {
+			return nil, fmt.Errorf("[%s] can not be overridden, it is not a question of revision [%s]", variable, revision.Name)
+		}
+	}
+
+	data, err := toMap(revision.Spec.ClusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]interface{}{}
+	for _, field := range TemplatedFields {
+		if value, ok := data[field]; ok {
+			result[field] = value
+		}
+	}
+
+	for _, question := range revision.Spec.Questions {
+		answer, ok := answers[question.Variable]
+		if !ok {
+			answer = question.Default
+		}
+		if answer == "" {
+			if question.Required {
+				return nil, fmt.Errorf("an answer to [%s] is required", question.Variable)
+			}
+			if ok {
+				setPath(result, strings.Split(question.Variable, "."), nil)
+			}
+			continue
+		}
+
+		field, _ := templatedField(question.Variable)
+		value, err := convertAnswer(question, field, answer)
+		if err != nil {
+			return nil, err
+		}
+		setPath(result, strings.Split(question.Variable, "."), value)
+	}
+
+	spec := &v3.ClusterSpec{}
+	if err := fromMap(result, spec); err != nil {
+		return nil, err
+	}
+	spec.ClusterTemplateName = revision.Spec.ClusterTemplateName
+	spec.ClusterTemplateRevisionName = revision.Name
+	if len(answers) > 0 {
+		spec.ClusterTemplateAnswers = map[string]string{}
+		for k, v := range answers {
+			spec.ClusterTemplateAnswers[k] = v
+		}
+	}
+	return spec, nil
+}
+
+// Apply sets the templated fields of spec to the revision with the answers of spec applied, it fails if the
+// revision is disabled
+func Apply(revision *v3.ClusterTemplateRevision, spec *v3.ClusterSpec) error {
+	resolved, err := Resolve(revision, spec.ClusterTemplateAnswers)
+	if err != nil {
+		return err
+	}
+
+	spec.RancherKubernetesEngineConfig = resolved.RancherKubernetesEngineConfig
+	spec.DefaultPodSecurityPolicyTemplateName = resolved.DefaultPodSecurityPolicyTemplateName
+	spec.DefaultClusterRoleForProjectMembers = resolved.DefaultClusterRoleForProjectMembers
+	spec.ClusterTemplateName = resolved.ClusterTemplateName
+	spec.ClusterTemplateRevisionName = resolved.ClusterTemplateRevisionName
+	return nil
+}
+
+// Drift returns the paths of the templated fields of spec that differ from the revision with the answers
+// of spec applied, in sorted order. Clusters of disabled revisions keep being compared to them.
+func Drift(revision *v3.ClusterTemplateRevision, spec *v3.ClusterSpec) ([]string, error) {
+	resolved, err := resolve(revision, spec.ClusterTemplateAnswers)
+	if err != nil {
+		return nil, err
+	}
+
+	want, err := toMap(resolved)
+	if err != nil {
+		return nil, err
+	}
+	got, err := toMap(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for _, field := range TemplatedFields {
+		result = append(result, diff(field, want[field], got[field])...)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// ValidateUpdate rejects a spec of a cluster created from the revision that changes a field the revision
+// enforces. Fields that are questions of the revision can only be changed through answers.
+func ValidateUpdate(revision *v3.ClusterTemplateRevision, spec *v3.ClusterSpec) error {
+	if spec.ClusterTemplateRevisionName != revision.Name {
+		return fmt.Errorf("cluster refers to revision [%s], not [%s]", spec.ClusterTemplateRevisionName, revision.Name)
+	}
+
+	drift, err := Drift(revision, spec)
+	if err != nil {
+		return err
+	}
+	if len(drift) > 0 {
+		return fmt.Errorf("%s can not be changed, they are enforced by cluster template revision [%s]", strings.Join(drift, ", "), revision.Name)
+	}
+	return nil
+}
+
+// templatedField returns the go type of the field of the cluster spec at path, a dot separated list of
+// json names that starts with one of the templated fields
+func templatedField(path string) (reflect.Type, error) {
+	parts := strings.Split(path, ".")
+	templated := false
+	for _, field := range TemplatedFields {
+		templated = templated || parts[0] == field
+	}
+	if !templated {
+		return nil, fmt.Errorf("[%s] is not a field of the cluster spec a template controls", path)
+	}
+
+	t := reflect.TypeOf(v3.ClusterSpec{})
+	for _, part := range parts {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			field, ok := jsonField(t, part)
+			if !ok {
+				return nil, fmt.Errorf("[%s] is not a field of the cluster spec", path)
+			}
+			t = field
+		case reflect.Map:
+			t = t.Elem()
+		default:
+			return nil, fmt.Errorf("[%s] is not a field of the cluster spec", path)
+		}
+	}
+	return t, nil
+}
+
+// jsonField returns the type of the field of t with the json name, including fields of inlined structs
+func jsonField(t reflect.Type, name string) (reflect.Type, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if field.Anonymous && jsonName == "" {
+			if result, ok := jsonField(field.Type, name); ok {
+				return result, true
+			}
+			continue
+		}
+		if jsonName == name {
+			return field.Type, true
+		}
+	}
+	return nil, false
+}
+
+// answerable returns whether a field of type t can be set from an answer
+func answerable(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int32, reflect.Int64:
+		return true
+	case reflect.Slice:
+		return t.Elem().Kind() == reflect.String
+	}
+	return false
+}
+
+// convertAnswer converts an answer to the json value of a field of type t
+func convertAnswer(question v3.Question, t reflect.Type, answer string) (interface{}, error) {
+	if len(question.Options) > 0 && !contains(question.Options, answer) {
+		return nil, fmt.Errorf("[%s] is not a valid answer to [%s], must be one of %v", answer, question.Variable, question.Options)
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return answer, nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(answer)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] is not a valid answer to [%s], must be true or false", answer, question.Variable)
+		}
+		return b, nil
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(answer, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] is not a valid answer to [%s], must be a number", answer, question.Variable)
+		}
+		return n, nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.String {
+			var result []interface{}
+			for _, item := range strings.Split(answer, ",") {
+				result = append(result, strings.TrimSpace(item))
+			}
+			return result, nil
+		}
+	}
+	return nil, fmt.Errorf("[%s] can not be a question, only strings, numbers, booleans and lists of strings can be answered", question.Variable)
+}
+
+func setPath(data map[string]interface{}, path []string, value interface{}) {
+	for _, key := range path[:len(path)-1] {
+		next, ok := data[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			data[key] = next
+		}
+		data = next
+	}
+	data[path[len(path)-1]] = value
+}
+
+// diff returns the paths below path where the json values want and got differ, a missing value equals the
+// empty value of its type
+func diff(path string, want, got interface{}) []string {
+	wantMap, wantIsMap := want.(map[string]interface{})
+	gotMap, gotIsMap := got.(map[string]interface{})
+	if wantIsMap || gotIsMap {
+		keys := map[string]bool{}
+		for k := range wantMap {
+			keys[k] = true
+		}
+		for k := range gotMap {
+			keys[k] = true
+		}
+
+		var result []string
+		for k := range keys {
+			result = append(result, diff(path+"."+k, wantMap[k], gotMap[k])...)
+		}
+		return result
+	}
+
+	if isEmpty(want) && isEmpty(got) || reflect.DeepEqual(want, got) {
+		return nil
+	}
+	return []string{path}
+}
+
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// checkEnabled rejects revisions no new clusters can be created from or upgraded to
+func checkEnabled(revision *v3.ClusterTemplateRevision) error {
+	if enabled := revision.Spec.Enabled; enabled != nil && !*enabled {
+		return fmt.Errorf("revision [%s] is disabled", revision.Name)
+	}
+	return nil
+}
+
+func toMap(obj interface{}) (map[string]interface{}, error) {
+	content, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]interface{}{}
+	return result, json.Unmarshal(content, &result)
+}
+
+func fromMap(data map[string]interface{}, obj interface{}) error {
+	content, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(content, obj)
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,338 @@
+package clustertemplate
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+const templateName = "cattle-global-data:ct-7v2xq"
+
+func newRevision() *v3.ClusterTemplateRevision {
+	revision := &v3.ClusterTemplateRevision{
+		Spec: v3.ClusterTemplateRevisionSpec{
+			ClusterTemplateName: templateName,
+			ClusterConfig: &v3.ClusterSpec{
+				DisplayName: "ignored",
+				RancherKubernetesEngineConfig: &v3.RancherKubernetesEngineConfig{
+					Version:         "v1.17.6-rancher2-2",
+					AddonJobTimeout: 30,
+					Network:         v3.NetworkConfig{Plugin: "canal"},
+					Services: v3.RKEConfigServices{
+						KubeAPI: v3.KubeAPIService{PodSecurityPolicy: true},
+					},
+				},
+				DefaultPodSecurityPolicyTemplateName: "restricted",
+				DefaultClusterRoleForProjectMembers:  "project-member",
+			},
+			Questions: []v3.Question{
+				{
+					Variable: "rancherKubernetesEngineConfig.kubernetesVersion",
+					Default:  "v1.18.3-rancher2-2",
+					Options:  []string{"v1.17.6-rancher2-2", "v1.18.3-rancher2-2"},
+				},
+				{Variable: "rancherKubernetesEngineConfig.addonJobTimeout"},
+				{Variable: "rancherKubernetesEngineConfig.services.kubeApi.podSecurityPolicy"},
+				{Variable: "defaultPodSecurityPolicyTemplateName"},
+				{Variable: "defaultClusterRoleForProjectMembers", Default: "project-member", Required: true},
+			},
+		},
+	}
+	revision.Name = "cattle-global-data:ctr-m4k8d"
+	return revision
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name    string
+		answers map[string]string
+		check   func(*v3.ClusterSpec) interface{}
+		want    interface{}
+		invalid bool
+	}{
+		{
+			name:  "default of the question",
+			check: func(s *v3.ClusterSpec) interface{} { return s.RancherKubernetesEngineConfig.Version },
+			want:  "v1.18.3-rancher2-2",
+		},
+		{
+			name:    "answer",
+			answers: map[string]string{"rancherKubernetesEngineConfig.kubernetesVersion": "v1.17.6-rancher2-2"},
+			check:   func(s *v3.ClusterSpec) interface{} { return s.RancherKubernetesEngineConfig.Version },
+			want:    "v1.17.6-rancher2-2",
+		},
+		{
+			name:  "unset answer keeps the value of the revision",
+			check: func(s *v3.ClusterSpec) interface{} { return s.DefaultPodSecurityPolicyTemplateName },
+			want:  "restricted",
+		},
+		{
+			name:    "empty answer clears the value of the revision",
+			answers: map[string]string{"defaultPodSecurityPolicyTemplateName": ""},
+			check:   func(s *v3.ClusterSpec) interface{} { return s.DefaultPodSecurityPolicyTemplateName },
+			want:    "",
+		},
+		{
+			name:    "empty boolean answer",
+			answers: map[string]string{"rancherKubernetesEngineConfig.services.kubeApi.podSecurityPolicy": ""},
+			check: func(s *v3.ClusterSpec) interface{} {
+				return s.RancherKubernetesEngineConfig.Services.KubeAPI.PodSecurityPolicy
+			},
+			want: false,
+		},
+		{
+			name:    "number",
+			answers: map[string]string{"rancherKubernetesEngineConfig.addonJobTimeout": "45"},
+			check:   func(s *v3.ClusterSpec) interface{} { return s.RancherKubernetesEngineConfig.AddonJobTimeout },
+			want:    45,
+		},
+		{
+			name:  "enforced field",
+			check: func(s *v3.ClusterSpec) interface{} { return s.RancherKubernetesEngineConfig.Network.Plugin },
+			want:  "canal",
+		},
+		{
+			name:  "fields set per cluster",
+			check: func(s *v3.ClusterSpec) interface{} { return s.DisplayName },
+			want:  "",
+		},
+		{
+			name: "template references",
+			check: func(s *v3.ClusterSpec) interface{} {
+				return s.ClusterTemplateName + " " + s.ClusterTemplateRevisionName
+			},
+			want: templateName + " cattle-global-data:ctr-m4k8d",
+		},
+		{
+			name:    "answers are kept",
+			answers: map[string]string{"defaultPodSecurityPolicyTemplateName": ""},
+			check:   func(s *v3.ClusterSpec) interface{} { return s.ClusterTemplateAnswers },
+			want:    map[string]string{"defaultPodSecurityPolicyTemplateName": ""},
+		},
+		{
+			name:    "empty answer to a required question",
+			answers: map[string]string{"defaultClusterRoleForProjectMembers": ""},
+			invalid: true,
+		},
+		{
+			name:    "not a question",
+			answers: map[string]string{"rancherKubernetesEngineConfig.network.plugin": "calico"},
+			invalid: true,
+		},
+		{
+			name:    "not an option",
+			answers: map[string]string{"rancherKubernetesEngineConfig.kubernetesVersion": "v1.16.10-rancher2-1"},
+			invalid: true,
+		},
+		{
+			name:    "invalid boolean",
+			answers: map[string]string{"rancherKubernetesEngineConfig.services.kubeApi.podSecurityPolicy": "yes please"},
+			invalid: true,
+		},
+		{
+			name:    "invalid number",
+			answers: map[string]string{"rancherKubernetesEngineConfig.addonJobTimeout": "45s"},
+			invalid: true,
+		},
+	}
+
+	for _, test := range tests {
+		spec, err := Resolve(newRevision(), test.answers)
+		if test.invalid {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if got := test.check(spec); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.want, got)
+		}
+	}
+}
+
+func TestValidateRevision(t *testing.T) {
+	tests := []struct {
+		name     string
+		question v3.Question
+	}{
+		{
+			name:     "field set per cluster",
+			question: v3.Question{Variable: "displayName"},
+		},
+		{
+			name:     "unknown field",
+			question: v3.Question{Variable: "rancherKubernetesEngineConfig.kubernetesVersions"},
+		},
+		{
+			name:     "struct field",
+			question: v3.Question{Variable: "rancherKubernetesEngineConfig.network"},
+		},
+		{
+			name:     "invalid default",
+			question: v3.Question{Variable: "rancherKubernetesEngineConfig.ignoreDockerVersion", Default: "maybe"},
+		},
+		{
+			name:     "duplicate",
+			question: v3.Question{Variable: "defaultPodSecurityPolicyTemplateName"},
+		},
+	}
+
+	if err := ValidateRevision(newRevision()); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	for _, test := range tests {
+		revision := newRevision()
+		revision.Spec.Questions = append(revision.Spec.Questions, test.question)
+		if err := ValidateRevision(revision); err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+	}
+}
+
+func TestDrift(t *testing.T) {
+	tests := []struct {
+		name    string
+		answers map[string]string
+		modify  func(*v3.ClusterSpec)
+		drift   []string
+	}{
+		{
+			name:   "resolved spec",
+			modify: func(s *v3.ClusterSpec) {},
+		},
+		{
+			name: "field set per cluster",
+			modify: func(s *v3.ClusterSpec) {
+				s.DisplayName = "production"
+				s.Description = "changed"
+			},
+		},
+		{
+			name: "enforced field",
+			modify: func(s *v3.ClusterSpec) {
+				s.RancherKubernetesEngineConfig.Network.Plugin = "calico"
+			},
+			drift: []string{"rancherKubernetesEngineConfig.network.plugin"},
+		},
+		{
+			name: "enforced fields added",
+			modify: func(s *v3.ClusterSpec) {
+				s.RancherKubernetesEngineConfig.SSHKeyPath = "/home/rancher/.ssh/id_rsa"
+				s.RancherKubernetesEngineConfig.Services.KubeAPI.ExtraArgs = map[string]string{"v": "4"}
+			},
+			drift: []string{"rancherKubernetesEngineConfig.services.kubeApi.extraArgs.v", "rancherKubernetesEngineConfig.sshKeyPath"},
+		},
+		{
+			name: "question changed without an answer",
+			modify: func(s *v3.ClusterSpec) {
+				s.RancherKubernetesEngineConfig.Version = "v1.17.6-rancher2-2"
+			},
+			drift: []string{"rancherKubernetesEngineConfig.kubernetesVersion"},
+		},
+		{
+			name:    "question changed through an answer",
+			answers: map[string]string{"rancherKubernetesEngineConfig.kubernetesVersion": "v1.17.6-rancher2-2"},
+			modify: func(s *v3.ClusterSpec) {
+				s.RancherKubernetesEngineConfig.Version = "v1.17.6-rancher2-2"
+			},
+		},
+		{
+			name:    "field cleared through an empty answer",
+			answers: map[string]string{"defaultPodSecurityPolicyTemplateName": ""},
+			modify: func(s *v3.ClusterSpec) {
+				s.DefaultPodSecurityPolicyTemplateName = ""
+			},
+		},
+		{
+			name: "field cleared without an answer",
+			modify: func(s *v3.ClusterSpec) {
+				s.DefaultPodSecurityPolicyTemplateName = ""
+			},
+			drift: []string{"defaultPodSecurityPolicyTemplateName"},
+		},
+	}
+
+	for _, test := range tests {
+		resolved, err := Resolve(newRevision(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resolved.ClusterTemplateAnswers = test.answers
+		test.modify(resolved)
+
+		drift, err := Drift(newRevision(), resolved)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(drift, test.drift) {
+			t.Errorf("%s: expected drift %v, got %v", test.name, test.drift, drift)
+		}
+
+		err = ValidateUpdate(newRevision(), resolved)
+		if len(test.drift) == 0 && err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+		} else if len(test.drift) > 0 && err == nil {
+			t.Errorf("%s: expected the update to be rejected", test.name)
+		}
+	}
+}
+
+func TestValidateUpdateOfAnotherRevision(t *testing.T) {
+	spec, err := Resolve(newRevision(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec.ClusterTemplateRevisionName = "cattle-global-data:ctr-q9z2w"
+	if err := ValidateUpdate(newRevision(), spec); err == nil {
+		t.Error("expected a spec of another revision to be rejected")
+	}
+}
+
+func TestApply(t *testing.T) {
+	spec := &v3.ClusterSpec{
+		DisplayName:            "production",
+		ClusterTemplateAnswers: map[string]string{"rancherKubernetesEngineConfig.kubernetesVersion": "v1.17.6-rancher2-2"},
+	}
+	if err := Apply(newRevision(), spec); err != nil {
+		t.Fatal(err)
+	}
+	if spec.DisplayName != "production" {
+		t.Errorf("expected the display name to be kept, got %s", spec.DisplayName)
+	}
+	if spec.RancherKubernetesEngineConfig.Version != "v1.17.6-rancher2-2" {
+		t.Errorf("expected the answered version, got %s", spec.RancherKubernetesEngineConfig.Version)
+	}
+	if spec.ClusterTemplateRevisionName != "cattle-global-data:ctr-m4k8d" {
+		t.Errorf("expected the spec to refer to the revision, got %s", spec.ClusterTemplateRevisionName)
+	}
+}
+
+func TestDisabledRevision(t *testing.T) {
+	enabled := false
+	revision := newRevision()
+	revision.Spec.Enabled = &enabled
+
+	if _, err := Resolve(revision, nil); err == nil {
+		t.Error("expected Resolve to reject the disabled revision")
+	}
+	if err := Apply(revision, &v3.ClusterSpec{}); err == nil {
+		t.Error("expected Apply to reject the disabled revision")
+	}
+
+	enabled = true
+	spec := &v3.ClusterSpec{}
+	if err := Apply(revision, spec); err != nil {
+		t.Fatalf("unexpected error of the enabled revision: %v", err)
+	}
+
+	enabled = false
+	if drift, err := Drift(revision, spec); err != nil || len(drift) != 0 {
+		t.Errorf("expected no drift of a cluster of the disabled revision, got %v, %v", drift, err)
+	}
+}
@@ -0,0 +1,279 @@
+package clusteryml
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"gopkg.in/yaml.v2"
+)
+
+// fileKeys maps the yaml keys of the RKE types to their name in cluster.yml where the two differ, indexed by
+// the cluster.yml path of the object that holds them. The yaml keys of the types are kept as they are for
+// the configs already stored with them.
+var fileKeys = map[string]map[string]string{
+	"system_images": {
+		"wave_node": "weave_node",
+	},
+	"network": {
+		"caliconetworkprovider":  "calico_network_provider",
+		"canalnetworkprovider":   "canal_network_provider",
+		"flannelnetworkprovider": "flannel_network_provider",
+	},
+	"network.calico_network_provider": {
+		"cloudprovider": "cloud_provider",
+	},
+	"cloud_provider": {
+		"awscloudprovider":   "awsCloudProvider",
+		"azurecloudprovider": "azureCloudProvider",
+	},
+	"cloud_provider.azureCloudProvider": {
+		"usemanagedidentityextension":  "useManagedIdentityExtension",
+		"maximumloadbalancerrulecount": "maximumLoadBalancerRuleCount",
+	},
+}
+
+// Parse reads a cluster.yml document. The yaml keys of the RKE types are accepted in place of their
+// cluster.yml name, keys that do not map to any field are returned by path and otherwise ignored.
+func Parse(data []byte) (*v3.RancherKubernetesEngineConfig, []string, error) {
+	raw := map[interface{}]interface{}{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse cluster.yml: %v", err)
+	}
+
+	var unknown []string
+	normalized := normalize("", reflect.TypeOf(v3.RancherKubernetesEngineConfig{}), raw, &unknown)
+	sort.Strings(unknown)
+
+	content, err := yaml.Marshal(normalized)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config := &v3.RancherKubernetesEngineConfig{}
+	if err := yaml.Unmarshal(content, config); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse cluster.yml: %v", err)
+	}
+
+	return config, unknown, nil
+}
+
+// Marshal writes config as a cluster.yml document, Parse of the result returns an identical config.
+// Empty values are left out, so nil and empty lists or maps are not told apart.
+func Marshal(config *v3.RancherKubernetesEngineConfig) ([]byte, error) {
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	data := yaml.MapSlice{}
+	if err := yaml.Unmarshal(content, &data); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(prune("", reflect.TypeOf(config), data))
+}
+
+// Redact returns a copy of config with all credentials removed so that it can be shared
+func Redact(config *v3.RancherKubernetesEngineConfig) *v3.RancherKubernetesEngineConfig {
+	result := config.DeepCopy()
+
+	for i := range result.Nodes {
+		result.Nodes[i].SSHKey = ""
+	}
+	result.BastionHost.SSHKey = ""
+	for i := range result.PrivateRegistries {
+		result.PrivateRegistries[i].Password = ""
+	}
+	result.Services.Etcd.Key = ""
+	if encryption := result.Services.KubeAPI.SecretsEncryptionConfig; encryption != nil {
+		for i := range encryption.Providers {
+			for j := range encryption.Providers[i].Keys {
+				encryption.Providers[i].Keys[j].Secret = ""
+			}
+		}
+	}
+	if azure := result.CloudProvider.AzureCloudProvider; azure != nil {
+		azure.AADClientSecret = ""
+		azure.AADClientCertPassword = ""
+	}
+	if vsphere := result.CloudProvider.VsphereCloudProvider; vsphere != nil {
+		vsphere.Global.Password = ""
+		for server, vc := range vsphere.VirtualCenter {
+			vc.Password = ""
+			vsphere.VirtualCenter[server] = vc
+		}
+	}
+	if openstack := result.CloudProvider.OpenstackCloudProvider; openstack != nil {
+		openstack.Global.Password = ""
+	}
+
+	return result
+}
+
+// normalize walks value alongside the go type it will be decoded into, renaming the cluster.yml keys to
+// the yaml keys of the types and recording keys that have no matching field
+func normalize(path string, t reflect.Type, value interface{}, unknown *[]string) interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		data, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return value
+		}
+		fields := yamlFields(t)
+		typeKeys := map[string]string{}
+		for typeKey, fileKey := range fileKeys[path] {
+			typeKeys[fileKey] = typeKey
+		}
+		result := map[interface{}]interface{}{}
+		for k, v := range data {
+			key, fileKey := fmt.Sprint(k), fmt.Sprint(k)
+			if typeKey, ok := typeKeys[key]; ok {
+				key = typeKey
+			} else if name, ok := fileKeys[path][key]; ok {
+				if _, exists := data[name]; exists {
+					continue
+				}
+				fileKey = name
+			}
+			fieldType, ok := fields[key]
+			if !ok {
+				*unknown = append(*unknown, join(path, fileKey))
+				continue
+			}
+			result[key] = normalize(join(path, fileKey), fieldType, v, unknown)
+		}
+		return result
+	case reflect.Slice:
+		data, ok := value.([]interface{})
+		if !ok {
+			return value
+		}
+		for i, v := range data {
+			data[i] = normalize(fmt.Sprintf("%s[%d]", path, i), t.Elem(), v, unknown)
+		}
+		return data
+	case reflect.Map:
+		data, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return value
+		}
+		for k, v := range data {
+			data[k] = normalize(join(path, fmt.Sprint(k)), t.Elem(), v, unknown)
+		}
+		return data
+	}
+
+	return value
+}
+
+// yamlFields returns the type of every field of t by the key yaml uses for it, including inlined fields
+func yamlFields(t reflect.Type) map[string]reflect.Type {
+	result := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		tag := field.Tag.Get("yaml")
+		if tag == "-" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		name := parts[0]
+		inline := false
+		for _, opt := range parts[1:] {
+			if opt == "inline" {
+				inline = true
+			}
+		}
+
+		if inline {
+			for k, v := range yamlFields(field.Type) {
+				result[k] = v
+			}
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		result[name] = field.Type
+	}
+	return result
+}
+
+// prune drops the empty fields of structs and renames their keys to the cluster.yml names, entries of maps
+// and lists are kept as is. The field order of the struct is preserved.
+func prune(path string, t reflect.Type, value interface{}) interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch v := value.(type) {
+	case yaml.MapSlice:
+		if t.Kind() != reflect.Struct {
+			return v
+		}
+		fields := yamlFields(t)
+		result := yaml.MapSlice{}
+		for _, item := range v {
+			key := fmt.Sprint(item.Key)
+			fieldType, ok := fields[key]
+			if !ok {
+				continue
+			}
+			if name, ok := fileKeys[path][key]; ok {
+				key = name
+			}
+			// a set pointer is kept even when empty, its presence enables a feature
+			pruned := prune(join(path, key), fieldType, item.Value)
+			if !isEmpty(pruned) || (fieldType.Kind() == reflect.Ptr && item.Value != nil) {
+				result = append(result, yaml.MapItem{Key: key, Value: pruned})
+			}
+		}
+		return result
+	case []interface{}:
+		if t.Kind() != reflect.Slice {
+			return v
+		}
+		for i := range v {
+			v[i] = prune(fmt.Sprintf("%s[%d]", path, i), t.Elem(), v[i])
+		}
+		return v
+	}
+	return value
+}
+
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case yaml.MapSlice:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case int:
+		return v == 0
+	}
+	return false
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
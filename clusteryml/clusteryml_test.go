@@ -0,0 +1,170 @@
+package clusteryml
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+const legacyDocument = `
+nodes:
+- address: 10.0.0.1
+  role: [etcd, controlplane, worker]
+  user: ubuntu
+  ssh_key: key
+system_images:
+  wave_node: weaveworks/weave-kube:2.1.2
+network:
+  plugin: calico
+  caliconetworkprovider:
+    cloudprovider: aws
+cloud_provider:
+  name: azure
+  azurecloudprovider:
+    tenantId: tenant
+    aadClientSecret: secret
+    usemanagedidentityextension: true
+    maximumloadbalancerrulecount: 100
+ignore_docker_version: true
+unknown_key: value
+`
+
+func TestParseLegacyKeys(t *testing.T) {
+	config, unknown, err := Parse([]byte(legacyDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(unknown, []string{"unknown_key"}) {
+		t.Errorf("expected the unknown key unknown_key, got %v", unknown)
+	}
+	if config.SystemImages.WeaveNode != "weaveworks/weave-kube:2.1.2" {
+		t.Errorf("expected the weave node image of wave_node, got %q", config.SystemImages.WeaveNode)
+	}
+	if calico := config.Network.CalicoNetworkProvider; calico == nil || calico.CloudProvider != "aws" {
+		t.Errorf("expected the calico provider of caliconetworkprovider, got %+v", calico)
+	}
+	azure := config.CloudProvider.AzureCloudProvider
+	if azure == nil || !azure.UseManagedIdentityExtension || azure.MaximumLoadBalancerRuleCount != 100 {
+		t.Fatalf("expected the azure provider of azurecloudprovider, got %+v", azure)
+	}
+
+	data, err := Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"weave_node:", "calico_network_provider:", "cloud_provider: aws", "azureCloudProvider:", "useManagedIdentityExtension: true", "maximumLoadBalancerRuleCount: 100"} {
+		if !strings.Contains(string(data), key) {
+			t.Errorf("expected %q in\n%s", key, data)
+		}
+	}
+	for _, key := range []string{"wave_node", "caliconetworkprovider", "cloudprovider", "azurecloudprovider", "usemanagedidentityextension"} {
+		if strings.Contains(string(data), key) {
+			t.Errorf("expected no legacy key %q in\n%s", key, data)
+		}
+	}
+
+	reparsed, unknown, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unknown) > 0 {
+		t.Errorf("expected no unknown keys, got %v", unknown)
+	}
+	if !reflect.DeepEqual(reparsed, config) {
+		t.Errorf("expected the parsed config\n%+v\ngot\n%+v", config, reparsed)
+	}
+}
+
+func TestParsePrefersClusterYMLKeys(t *testing.T) {
+	config, unknown, err := Parse([]byte("system_images:\n  wave_node: old\n  weave_node: new\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unknown) > 0 {
+		t.Errorf("expected no unknown keys, got %v", unknown)
+	}
+	if config.SystemImages.WeaveNode != "new" {
+		t.Errorf("expected the image of weave_node, got %q", config.SystemImages.WeaveNode)
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	config := testConfig()
+
+	data, err := Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, unknown, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unknown) > 0 {
+		t.Errorf("expected no unknown keys, got %v", unknown)
+	}
+	if !reflect.DeepEqual(parsed, config) {
+		t.Errorf("expected the config\n%+v\ngot\n%+v\nfrom\n%s", config, parsed, data)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	config := testConfig()
+
+	redacted := Redact(config)
+	data, err := Marshal(redacted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"node-key", "bastion-key", "registry-password", "etcd-key", "encryption-secret", "aad-secret", "aad-cert-password", "vsphere-password", "vcenter-password", "openstack-password"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("expected %s to be removed from\n%s", secret, data)
+		}
+	}
+	if redacted.Nodes[0].Address != "10.0.0.1" || redacted.CloudProvider.VsphereCloudProvider.Global.User != "administrator" {
+		t.Errorf("expected the fields other than credentials to be kept, got %+v", redacted)
+	}
+
+	if !reflect.DeepEqual(config, testConfig()) {
+		t.Error("expected the config passed to Redact to be left unchanged")
+	}
+}
+
+func testConfig() *v3.RancherKubernetesEngineConfig {
+	config := &v3.RancherKubernetesEngineConfig{
+		Nodes: []v3.RKEConfigNode{
+			{Address: "10.0.0.1", Role: []string{"etcd", "controlplane", "worker"}, User: "ubuntu", SSHKey: "node-key"},
+		},
+		BastionHost:       v3.BastionHost{Address: "bastion.example.com", SSHKey: "bastion-key"},
+		PrivateRegistries: []v3.PrivateRegistry{{URL: "registry.example.com", User: "admin", Password: "registry-password"}},
+		SystemImages:      v3.RKESystemImages{WeaveNode: "weaveworks/weave-kube:2.1.2"},
+		Network: v3.NetworkConfig{
+			Plugin:                "calico",
+			CalicoNetworkProvider: &v3.CalicoNetworkProvider{CloudProvider: "aws"},
+		},
+		CloudProvider: v3.CloudProvider{
+			Name: "azure",
+			AzureCloudProvider: &v3.AzureCloudProvider{
+				TenantID:                    "tenant",
+				AADClientSecret:             "aad-secret",
+				AADClientCertPassword:       "aad-cert-password",
+				UseManagedIdentityExtension: true,
+			},
+			VsphereCloudProvider: &v3.VsphereCloudProvider{
+				Global:        v3.GlobalVsphereOpts{User: "administrator", Password: "vsphere-password"},
+				VirtualCenter: map[string]v3.VirtualCenterConfig{"vc.example.com": {User: "administrator", Password: "vcenter-password"}},
+			},
+			OpenstackCloudProvider: &v3.OpenstackCloudProvider{
+				Global: v3.GlobalOpenstackOpts{AuthURL: "https://keystone.example.com", Password: "openstack-password"},
+			},
+		},
+	}
+	config.Services.Etcd.Key = "etcd-key"
+	config.Services.KubeAPI.SecretsEncryptionConfig = &v3.SecretsEncryptionConfig{
+		Enabled:   true,
+		Providers: []v3.EncryptionProvider{{Type: "aescbc", Keys: []v3.EncryptionKey{{Name: "key1", Secret: "encryption-secret"}}}},
+	}
+	return config
+}
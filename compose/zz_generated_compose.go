@@ -25,6 +25,9 @@ type Config struct {
 	Clusters                                 map[string]managementClient.Cluster                                 `json:"clusters,omitempty" yaml:"clusters,omitempty"`
 	ClusterEvents                            map[string]managementClient.ClusterEvent                            `json:"clusterEvents,omitempty" yaml:"clusterEvents,omitempty"`
 	ClusterRegistrationTokens                map[string]managementClient.ClusterRegistrationToken                `json:"clusterRegistrationTokens,omitempty" yaml:"clusterRegistrationTokens,omitempty"`
+	ClusterBackups                           map[string]managementClient.ClusterBackup                           `json:"clusterBackups,omitempty" yaml:"clusterBackups,omitempty"`
+	ClusterTemplates                         map[string]managementClient.ClusterTemplate                         `json:"clusterTemplates,omitempty" yaml:"clusterTemplates,omitempty"`
+	ClusterTemplateRevisions                 map[string]managementClient.ClusterTemplateRevision                 `json:"clusterTemplateRevisions,omitempty" yaml:"clusterTemplateRevisions,omitempty"`
 	Catalogs                                 map[string]managementClient.Catalog                                 `json:"catalogs,omitempty" yaml:"catalogs,omitempty"`
 	Templates                                map[string]managementClient.Template                                `json:"templates,omitempty" yaml:"templates,omitempty"`
 	TemplateVersions                         map[string]managementClient.TemplateVersion                         `json:"templateVersions,omitempty" yaml:"templateVersions,omitempty"`
@@ -42,6 +45,9 @@ type Config struct {
 	Notifiers                                map[string]managementClient.Notifier                                `json:"notifiers,omitempty" yaml:"notifiers,omitempty"`
 	ClusterAlerts                            map[string]managementClient.ClusterAlert                            `json:"clusterAlerts,omitempty" yaml:"clusterAlerts,omitempty"`
 	ProjectAlerts                            map[string]managementClient.ProjectAlert                            `json:"projectAlerts,omitempty" yaml:"projectAlerts,omitempty"`
+	ClusterAlertGroups                       map[string]managementClient.ClusterAlertGroup                       `json:"clusterAlertGroups,omitempty" yaml:"clusterAlertGroups,omitempty"`
+	ProjectAlertGroups                       map[string]managementClient.ProjectAlertGroup                       `json:"projectAlertGroups,omitempty" yaml:"projectAlertGroups,omitempty"`
+	MonitorMetrics                           map[string]managementClient.MonitorMetric                           `json:"monitorMetrics,omitempty" yaml:"monitorMetrics,omitempty"`
 	ClusterPipelines                         map[string]managementClient.ClusterPipeline                         `json:"clusterPipelines,omitempty" yaml:"clusterPipelines,omitempty"`
 	SourceCodeCredentials                    map[string]managementClient.SourceCodeCredential                    `json:"sourceCodeCredentials,omitempty" yaml:"sourceCodeCredentials,omitempty"`
 	Pipelines                                map[string]managementClient.Pipeline                                `json:"pipelines,omitempty" yaml:"pipelines,omitempty"`
@@ -0,0 +1,321 @@
+package fluentd
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"k8s.io/api/core/v1"
+)
+
+const (
+	// ProjectIDAnnotation holds the project of a namespace
+	ProjectIDAnnotation = "field.cattle.io/projectId"
+	// EmbeddedElasticsearchURL is the service of the elasticsearch deployed for the embedded target
+	EmbeddedElasticsearchURL = "http://elasticsearch.cattle-logging:9200"
+
+	containerLogPath = "/var/log/containers/*.log"
+	positionFile     = "/fluentd/log/fluentd-containers.log.pos"
+	sourceTag        = "kubernetes.*"
+	clusterLabel     = "@cluster"
+)
+
+var (
+	labelChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+	// dateFormats maps the date formats of the index names to the formats of fluentd
+	dateFormats = map[string]string{
+		"YYYY-MM-DD": "%Y-%m-%d",
+		"YYYY-MM":    "%Y-%m",
+		"YYYY":       "%Y",
+	}
+)
+
+// Input holds the loggings of one cluster and the namespaces that route the logs of its projects
+type Input struct {
+	// ClusterLogging receives the logs of all namespaces, it may be nil
+	ClusterLogging  *v3.ClusterLogging
+	ProjectLoggings []*v3.ProjectLogging
+	Namespaces      []*v1.Namespace
+}
+
+// Config returns the fluentd config of input
+func Config(input Input) (string, error) {
+	directives, err := Build(input)
+	if err != nil {
+		return "", err
+	}
+	return Render(directives), nil
+}
+
+// Build returns the directives that tail the container logs and send them to the target of every logging.
+// The records are copied to a label per logging, the label of a project logging keeps the records of the
+// namespaces of the project and applies the filter of the logging before its output. Every logging is
+// checked, also those of projects without namespaces.
+func Build(input Input) ([]*Directive, error) {
+	projectNamespaces := map[string][]string{}
+	for _, ns := range input.Namespaces {
+		if project := ns.Annotations[ProjectIDAnnotation]; project != "" {
+			projectNamespaces[project] = append(projectNamespaces[project], ns.Name)
+		}
+	}
+
+	projectLoggings := append([]*v3.ProjectLogging{}, input.ProjectLoggings...)
+	sort.Slice(projectLoggings, func(i, j int) bool {
+		if projectLoggings[i].Namespace != projectLoggings[j].Namespace {
+			return projectLoggings[i].Namespace < projectLoggings[j].Namespace
+		}
+		return projectLoggings[i].Name < projectLoggings[j].Name
+	})
+
+	routes := NewDirective("match", "kubernetes.**").Param("@type", "copy")
+	var labels []*Directive
+
+	if cluster := input.ClusterLogging; cluster != nil {
+		label, err := loggingLabel(clusterLabel, cluster.Spec.LoggingCommonSpec, cluster.Spec.EmbeddedConfig, nil)
+		if err != nil {
+			return nil, fmt.Errorf("cluster logging %s: %v", cluster.Name, err)
+		}
+		routes.Add(relabel(clusterLabel))
+		labels = append(labels, label)
+	}
+
+	for _, logging := range projectLoggings {
+		namespaces := projectNamespaces[logging.Spec.ProjectName]
+		sort.Strings(namespaces)
+		// names of namespaces and objects have no underscores, so the label is unique per logging
+		name := "@project-" + labelChars.ReplaceAllString(logging.Namespace+"_"+logging.Name, "-")
+		label, err := loggingLabel(name, logging.Spec.LoggingCommonSpec, nil, namespaces)
+		if err != nil {
+			return nil, fmt.Errorf("project logging %s:%s: %v", logging.Namespace, logging.Name, err)
+		}
+		if len(namespaces) == 0 {
+			// the project has no logs to send
+			continue
+		}
+		routes.Add(relabel(name))
+		labels = append(labels, label)
+	}
+
+	if len(labels) == 0 {
+		return nil, nil
+	}
+
+	directives := []*Directive{
+		NewDirective("source", "").
+			Param("@type", "tail").
+			Param("path", containerLogPath).
+			Param("pos_file", positionFile).
+			Param("tag", sourceTag).
+			Param("read_from_head", "true").
+			Add(NewDirective("parse", "").
+				Param("@type", "json").
+				Param("time_key", "time").
+				Param("time_format", "%Y-%m-%dT%H:%M:%S.%NZ")),
+		NewDirective("filter", "kubernetes.**").
+			Param("@type", "kubernetes_metadata"),
+		routes,
+	}
+	return append(directives, labels...), nil
+}
+
+// loggingLabel returns the label that filters the records of a logging and sends them to its target, the
+// records are limited to namespaces unless it is nil
+func loggingLabel(name string, spec v3.LoggingCommonSpec, embedded *v3.EmbeddedConfig, namespaces []string) (*Directive, error) {
+	label := NewDirective("label", name)
+
+	if namespaces != nil {
+		label.Add(NewDirective("filter", "**").
+			Param("@type", "grep").
+			Add(match("regexp", namespaceKey, oneOf(namespaces))))
+	}
+
+	filters, err := Filters("**", spec.Filter)
+	if err != nil {
+		return nil, err
+	}
+	label.Add(filters...)
+
+	if len(spec.OutputTags) > 0 {
+		record := NewDirective("record", "")
+		for _, key := range sortedKeys(spec.OutputTags) {
+			record.Param(key, spec.OutputTags[key])
+		}
+		label.Add(NewDirective("filter", "**").
+			Param("@type", "record_transformer").
+			Add(record))
+	}
+
+	out, err := output(spec, embedded)
+	if err != nil {
+		return nil, err
+	}
+	return label.Add(out), nil
+}
+
+// output returns the match that sends the records to the one target of spec
+func output(spec v3.LoggingCommonSpec, embedded *v3.EmbeddedConfig) (*Directive, error) {
+	var targets []string
+	for name, set := range map[string]bool{
+		"elasticsearchConfig": spec.ElasticsearchConfig != nil,
+		"splunkConfig":        spec.SplunkConfig != nil,
+		"kafkaConfig":         spec.KafkaConfig != nil,
+		"syslogConfig":        spec.SyslogConfig != nil,
+		"embeddedConfig":      embedded != nil,
+	} {
+		if set {
+			targets = append(targets, name)
+		}
+	}
+	sort.Strings(targets)
+	switch len(targets) {
+	case 0:
+		return nil, fmt.Errorf("a logging target is required")
+	case 1:
+	default:
+		return nil, fmt.Errorf("only one logging target can be set, found %s", strings.Join(targets, ", "))
+	}
+
+	var (
+		match *Directive
+		err   error
+	)
+	switch {
+	case spec.ElasticsearchConfig != nil:
+		match, err = elasticsearch(spec.ElasticsearchConfig)
+	case spec.SplunkConfig != nil:
+		match, err = splunk(spec.SplunkConfig)
+	case spec.KafkaConfig != nil:
+		match, err = kafka(spec.KafkaConfig)
+	case spec.SyslogConfig != nil:
+		match, err = syslog(spec.SyslogConfig)
+	case embedded != nil:
+		match, err = elasticsearch(&v3.ElasticsearchConfig{
+			Endpoint:    EmbeddedElasticsearchURL,
+			IndexPrefix: embedded.IndexPrefix,
+			DateFormat:  embedded.DateFormat,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.OutputFlushInterval > 0 {
+		match.Add(NewDirective("buffer", "").
+			Param("flush_interval", strconv.Itoa(spec.OutputFlushInterval)+"s"))
+	}
+	return match, nil
+}
+
+func elasticsearch(config *v3.ElasticsearchConfig) (*Directive, error) {
+	scheme, host, port, err := endpoint(config.Endpoint, "9200")
+	if err != nil {
+		return nil, err
+	}
+	dateFormat, ok := dateFormats[or(config.DateFormat, "YYYY-MM-DD")]
+	if !ok {
+		return nil, fmt.Errorf("unsupported date format [%s]", config.DateFormat)
+	}
+	return NewDirective("match", "**").
+		Param("@type", "elasticsearch").
+		Param("scheme", scheme).
+		Param("host", host).
+		Param("port", port).
+		Param("user", config.AuthUserName).
+		Param("password", config.AuthPassword).
+		Param("ssl_verify", strconv.FormatBool(config.SSLVerify)).
+		Param("include_tag_key", "true").
+		Param("logstash_format", "true").
+		Param("logstash_prefix", config.IndexPrefix).
+		Param("logstash_dateformat", dateFormat).
+		Param("type_name", "container_log"), nil
+}
+
+func splunk(config *v3.SplunkConfig) (*Directive, error) {
+	scheme, host, port, err := endpoint(config.Endpoint, "8088")
+	if err != nil {
+		return nil, err
+	}
+	return NewDirective("match", "**").
+		Param("@type", "splunk_hec").
+		Param("protocol", scheme).
+		Param("hec_host", host).
+		Param("hec_port", port).
+		Param("hec_token", config.Token).
+		Param("source", config.Source).
+		Param("insecure_ssl", strconv.FormatBool(!config.SSLVerify)), nil
+}
+
+func kafka(config *v3.KafkaConfig) (*Directive, error) {
+	match := NewDirective("match", "**").
+		Param("@type", "kafka_buffered").
+		Param("default_topic", config.Topic).
+		Param("output_data_type", "json")
+
+	switch {
+	case len(config.BrokerEndpoints) > 0:
+		var brokers []string
+		for _, broker := range config.BrokerEndpoints {
+			_, host, port, err := endpoint(broker, "9092")
+			if err != nil {
+				return nil, err
+			}
+			brokers = append(brokers, net.JoinHostPort(host, port))
+		}
+		match.Param("brokers", strings.Join(brokers, ","))
+	case config.ZookeeperEndpoint != "":
+		_, host, port, err := endpoint(config.ZookeeperEndpoint, "2181")
+		if err != nil {
+			return nil, err
+		}
+		match.Param("zookeeper", net.JoinHostPort(host, port))
+	default:
+		return nil, fmt.Errorf("brokerEndpoints or zookeeperEndpoint is required")
+	}
+	return match, nil
+}
+
+func syslog(config *v3.SyslogConfig) (*Directive, error) {
+	_, host, port, err := endpoint(config.Endpoint, "514")
+	if err != nil {
+		return nil, err
+	}
+	return NewDirective("match", "**").
+		Param("@type", "remote_syslog").
+		Param("host", host).
+		Param("port", port).
+		Param("protocol", or(config.Protocol, "udp")).
+		Param("severity", or(config.Severity, "notice")).
+		Param("program", config.Program), nil
+}
+
+// endpoint splits a URL or a host and port, the scheme defaults to http and the port to defaultPort
+func endpoint(value, defaultPort string) (string, string, string, error) {
+	withScheme := value
+	if !strings.Contains(value, "://") {
+		withScheme = "http://" + value
+	}
+	u, err := url.Parse(withScheme)
+	if err != nil || u.Hostname() == "" {
+		return "", "", "", fmt.Errorf("invalid endpoint [%s]", value)
+	}
+	return u.Scheme, u.Hostname(), or(u.Port(), defaultPort), nil
+}
+
+func relabel(label string) *Directive {
+	return NewDirective("store", "").
+		Param("@type", "relabel").
+		Param("@label", label)
+}
+
+func or(value, fallback string) string {
+	if value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,13 @@
+package fluentd
+
+import (
+	"testing"
+
+	"github.com/rancher/types/internal/golden"
+)
+
+func TestConfig(t *testing.T) {
+	golden.Run(t, func() interface{} { return &Input{} }, func(input interface{}) (string, error) {
+		return Config(*input.(*Input))
+	})
+}
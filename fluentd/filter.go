@@ -0,0 +1,135 @@
+package fluentd
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+const (
+	namespaceKey = "$.kubernetes.namespace_name"
+	containerKey = "$.kubernetes.container_name"
+	logKey       = "log"
+)
+
+// DefaultLogLevelPattern extracts the common level names when the level extraction of a filter has no
+// pattern
+const DefaultLogLevelPattern = `(?i)\b(?P<level>trace|debug|info|warn|warning|error|err|fatal|panic|critical)\b`
+
+// Filters returns the filter directives that apply filter to the records of tag, in the order they have to
+// be applied. Include selectors keep the records that match all of their criteria, exclude selectors drop
+// the records that match any of their criteria, a workload selector matches when all of its labels match.
+func Filters(tag string, filter *v3.LoggingFilter) ([]*Directive, error) {
+	if filter == nil {
+		return nil, nil
+	}
+	if err := filter.Validate().Err(); err != nil {
+		return nil, err
+	}
+
+	var directives []*Directive
+	grep := func() *Directive {
+		d := NewDirective("filter", tag).Param("@type", "grep")
+		directives = append(directives, d)
+		return d
+	}
+
+	if include := filter.Include; include != nil && !emptySelector(include) {
+		d := grep()
+		if len(include.Namespaces) > 0 {
+			d.Add(match("regexp", namespaceKey, oneOf(include.Namespaces)))
+		}
+		for _, key := range sortedKeys(include.WorkloadSelector) {
+			d.Add(match("regexp", labelKey(key), oneOf([]string{include.WorkloadSelector[key]})))
+		}
+		if len(include.Containers) > 0 {
+			d.Add(match("regexp", containerKey, oneOf(include.Containers)))
+		}
+	}
+
+	if exclude := filter.Exclude; exclude != nil && !emptySelector(exclude) {
+		if len(exclude.Namespaces) > 0 || len(exclude.Containers) > 0 {
+			d := grep()
+			if len(exclude.Namespaces) > 0 {
+				d.Add(match("exclude", namespaceKey, oneOf(exclude.Namespaces)))
+			}
+			if len(exclude.Containers) > 0 {
+				d.Add(match("exclude", containerKey, oneOf(exclude.Containers)))
+			}
+		}
+		if len(exclude.WorkloadSelector) > 0 {
+			// the excludes of a grep filter drop records that match any of them, <and> requires all
+			and := NewDirective("and", "")
+			for _, key := range sortedKeys(exclude.WorkloadSelector) {
+				and.Add(match("exclude", labelKey(key), oneOf([]string{exclude.WorkloadSelector[key]})))
+			}
+			grep().Add(and)
+		}
+	}
+
+	if len(filter.IncludeLines) > 0 {
+		var patterns []string
+		for _, pattern := range filter.IncludeLines {
+			patterns = append(patterns, "(?:"+pattern+")")
+		}
+		grep().Add(match("regexp", logKey, regexpLiteral(strings.Join(patterns, "|"))))
+	}
+	if len(filter.ExcludeLines) > 0 {
+		d := grep()
+		for _, pattern := range filter.ExcludeLines {
+			d.Add(match("exclude", logKey, regexpLiteral(pattern)))
+		}
+	}
+
+	if extraction := filter.LevelExtraction; extraction != nil {
+		pattern := extraction.Pattern
+		if pattern == "" {
+			pattern = DefaultLogLevelPattern
+		}
+		// lines without a level are passed on as they are
+		directives = append(directives, NewDirective("filter", tag).
+			Param("@type", "parser").
+			Param("key_name", logKey).
+			Param("reserve_data", "true").
+			Param("emit_invalid_record_to_error", "false").
+			Add(NewDirective("parse", "").
+				Param("@type", "regexp").
+				Param("expression", regexpLiteral(pattern))))
+	}
+
+	return directives, nil
+}
+
+func match(name, key, pattern string) *Directive {
+	return NewDirective(name, "").Param("key", key).Param("pattern", pattern)
+}
+
+// oneOf returns the pattern that matches any of values exactly
+func oneOf(values []string) string {
+	var quoted []string
+	for _, v := range values {
+		quoted = append(quoted, regexp.QuoteMeta(v))
+	}
+	return regexpLiteral("^(" + strings.Join(quoted, "|") + ")$")
+}
+
+// labelKey returns the record accessor of the pod label, with the dots replaced as kubernetes_metadata does.
+// Label names may hold slashes, which the dot notation does not allow.
+func labelKey(label string) string {
+	return "$['kubernetes']['labels']['" + strings.Replace(label, ".", "_", -1) + "']"
+}
+
+func emptySelector(selector *v3.LoggingSelector) bool {
+	return len(selector.Namespaces) == 0 && len(selector.WorkloadSelector) == 0 && len(selector.Containers) == 0
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,102 @@
+// Package fluentd renders the fluentd configuration of the log collector from cluster and project logging
+// specs. Records are expected to carry the kubernetes metadata of the kubernetes_metadata filter with its
+// default settings, which replace the dots of label names with underscores.
+package fluentd
+
+import (
+	"bytes"
+	"strings"
+)
+
+var singleQuoteEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// Directive is a section of the config, such as <match tag> or the <buffer> of an output
+type Directive struct {
+	Name     string
+	Arg      string
+	Params   []Param
+	Children []*Directive
+}
+
+type Param struct {
+	Name  string
+	Value string
+}
+
+// NewDirective returns the directive <name arg>
+func NewDirective(name, arg string) *Directive {
+	return &Directive{Name: name, Arg: arg}
+}
+
+// Param adds a parameter, empty values are left out
+func (d *Directive) Param(name, value string) *Directive {
+	if value != "" {
+		d.Params = append(d.Params, Param{Name: name, Value: value})
+	}
+	return d
+}
+
+// Add adds the child directives
+func (d *Directive) Add(children ...*Directive) *Directive {
+	d.Children = append(d.Children, children...)
+	return d
+}
+
+// Render returns the directives in the format of fluentd config files
+func Render(directives []*Directive) string {
+	buf := &bytes.Buffer{}
+	for i, d := range directives {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		d.render(buf, "")
+	}
+	return buf.String()
+}
+
+func (d *Directive) render(buf *bytes.Buffer, indent string) {
+	buf.WriteString(indent + "<" + d.Name)
+	if d.Arg != "" {
+		buf.WriteString(" " + d.Arg)
+	}
+	buf.WriteString(">\n")
+	for _, p := range d.Params {
+		buf.WriteString(indent + "  " + p.Name + " " + value(p.Value) + "\n")
+	}
+	for _, child := range d.Children {
+		child.render(buf, indent+"  ")
+	}
+	buf.WriteString(indent + "</" + d.Name + ">\n")
+}
+
+// value quotes the values the config parser would cut short or change, regular expression literals are
+// written as they are. Values are single quoted as fluentd runs the ruby code of #{...} in double quoted
+// values. Values starting with a quote are quoted too, as are the ones starting with [ or { which would
+// be parsed as json with double quoted strings.
+func value(v string) string {
+	if len(v) > 1 && strings.HasPrefix(v, "/") && strings.HasSuffix(v, "/") {
+		return v
+	}
+	if strings.ContainsAny(v, "#\"\\\n") || strings.TrimSpace(v) != v || strings.IndexAny(v, "'[{") == 0 {
+		return "'" + singleQuoteEscaper.Replace(v) + "'"
+	}
+	return v
+}
+
+// regexpLiteral returns pattern as a ruby regular expression literal. Go only named groups are rewritten and
+// unescaped slashes are escaped.
+func regexpLiteral(pattern string) string {
+	pattern = strings.Replace(pattern, "(?P<", "(?<", -1)
+	buf := &bytes.Buffer{}
+	buf.WriteString("/")
+	escaped := false
+	for _, r := range pattern {
+		if r == '/' && !escaped {
+			buf.WriteRune('\\')
+		}
+		buf.WriteRune(r)
+		escaped = r == '\\' && !escaped
+	}
+	buf.WriteString("/")
+	return buf.String()
+}
@@ -0,0 +1,63 @@
+// Package golden runs the rendering tests of the packages on the inputs in their testdata directory. Every
+// input testdata/<case>.input.yaml has the expected output in testdata/<case>.golden, go test -update
+// rewrites the expected outputs.
+package golden
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+const inputSuffix = ".input.yaml"
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// Run decodes every input of testdata into a new value of newInput and compares the output of render to
+// the golden file of the input. An error is compared as the line "error: <message>".
+func Run(t *testing.T, newInput func() interface{}, render func(input interface{}) (string, error)) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*"+inputSuffix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no inputs found in testdata")
+	}
+
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), inputSuffix)
+		t.Run(name, func(t *testing.T) {
+			data, err := ioutil.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			input := newInput()
+			if err := yaml.Unmarshal(data, input); err != nil {
+				t.Fatalf("invalid input %s: %v", file, err)
+			}
+
+			actual, err := render(input)
+			if err != nil {
+				actual = "error: " + err.Error() + "\n"
+			}
+
+			goldenFile := strings.TrimSuffix(file, inputSuffix) + ".golden"
+			if *update {
+				if err := ioutil.WriteFile(goldenFile, []byte(actual), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			expected, err := ioutil.ReadFile(goldenFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual != string(expected) {
+				t.Errorf("the output differs from %s, got:\n%s", goldenFile, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package kubeapi
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "github.com/rancher/types/apis/core/v1"
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ProcessName is the name of the kube-apiserver process in the node plans
+	ProcessName = "kube-apiserver"
+
+	AuditPolicyFile      = "/etc/kubernetes/audit-policy.yaml"
+	EncryptionConfigFile = "/etc/kubernetes/encryption.yaml"
+
+	DefaultAuditLogPath      = "/var/log/kube-audit/audit-log.json"
+	DefaultAuditLogMaxAge    = 30
+	DefaultAuditLogMaxBackup = 10
+	DefaultAuditLogMaxSize   = 100
+	DefaultAuditLogFormat    = "json"
+)
+
+// DefaultAuditPolicy logs the metadata of every request
+const DefaultAuditPolicy = `apiVersion: audit.k8s.io/v1beta1
+kind: Policy
+rules:
+- level: Metadata
+`
+
+// Args returns the kube-apiserver arguments of the audit log and the secrets encryption of svc
+func Args(svc v3.KubeAPIService) map[string]string {
+	args := map[string]string{}
+
+	if audit := svc.AuditLog; audit != nil && audit.Enabled {
+		args["audit-policy-file"] = AuditPolicyFile
+		args["audit-log-path"] = orDefault(audit.Path, DefaultAuditLogPath)
+		args["audit-log-maxage"] = strconv.Itoa(intOrDefault(audit.MaxAge, DefaultAuditLogMaxAge))
+		args["audit-log-maxbackup"] = strconv.Itoa(intOrDefault(audit.MaxBackup, DefaultAuditLogMaxBackup))
+		args["audit-log-maxsize"] = strconv.Itoa(intOrDefault(audit.MaxSize, DefaultAuditLogMaxSize))
+		args["audit-log-format"] = orDefault(audit.Format, DefaultAuditLogFormat)
+	}
+
+	if encryption := svc.SecretsEncryptionConfig; encryption != nil && encryption.Enabled {
+		args["experimental-encryption-provider-config"] = EncryptionConfigFile
+	}
+
+	return args
+}
+
+// Binds returns the host directories kube-apiserver needs mounted for the audit log
+func Binds(svc v3.KubeAPIService) []string {
+	if audit := svc.AuditLog; audit != nil && audit.Enabled {
+		dir := path.Dir(orDefault(audit.Path, DefaultAuditLogPath))
+		return []string{fmt.Sprintf("%s:%s:z", dir, dir)}
+	}
+	return nil
+}
+
+// Files returns the audit policy and the encryption config that are deployed on the control plane nodes
+func Files(svc v3.KubeAPIService) ([]v3.File, error) {
+	var files []v3.File
+
+	if audit := svc.AuditLog; audit != nil && audit.Enabled {
+		files = append(files, v3.File{
+			Name:     AuditPolicyFile,
+			Contents: orDefault(audit.Policy, DefaultAuditPolicy),
+		})
+	}
+
+	if encryption := svc.SecretsEncryptionConfig; encryption != nil && encryption.Enabled {
+		contents, err := EncryptionConfig(encryption)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, v3.File{
+			Name:     EncryptionConfigFile,
+			Contents: contents,
+		})
+	}
+
+	return files, nil
+}
+
+// AddToPlan adds the arguments, binds and files of svc to the kube-apiserver process of the plan of a
+// control plane node. Arguments already set, for example through ExtraArgs, are not overridden. Adding to
+// the same plan again adds nothing new, files already in the plan are replaced with their current contents.
+func AddToPlan(svc v3.KubeAPIService, plan *v3.RKEConfigNodePlan) error {
+	process, ok := plan.Processes[ProcessName]
+	if !ok {
+		return fmt.Errorf("node [%s] has no %s process", plan.Address, ProcessName)
+	}
+
+	files, err := Files(svc)
+	if err != nil {
+		return err
+	}
+
+	set := map[string]bool{}
+	for _, arg := range process.Args {
+		set[argName(arg)] = true
+	}
+	args := Args(svc)
+	var names []string
+	for name := range args {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !set[name] {
+			process.Args = append(process.Args, fmt.Sprintf("--%s=%s", name, args[name]))
+		}
+	}
+	for _, bind := range Binds(svc) {
+		if !contains(process.Binds, bind) {
+			process.Binds = append(process.Binds, bind)
+		}
+	}
+	plan.Processes[ProcessName] = process
+
+	for _, file := range files {
+		replaced := false
+		for i := range plan.Files {
+			if plan.Files[i].Name == file.Name {
+				plan.Files[i] = file
+				replaced = true
+			}
+		}
+		if !replaced {
+			plan.Files = append(plan.Files, file)
+		}
+	}
+	return nil
+}
+
+// RewriteSecrets updates every secret of the cluster without changes if the key rotation of svc asks for it,
+// so that kube-apiserver encrypts them again with the active key and the old keys can be removed. It has to
+// run once the encryption config with the new active key is deployed on every control plane node. Secrets
+// deleted or updated in the meantime are skipped, the update encrypted them already. It returns the number
+// of secrets that were rewritten.
+func RewriteSecrets(svc v3.KubeAPIService, secrets corev1.SecretsGetter) (int, error) {
+	encryption := svc.SecretsEncryptionConfig
+	if encryption == nil || !encryption.Enabled || encryption.KeyRotation == nil || !encryption.KeyRotation.RewriteSecrets {
+		return 0, nil
+	}
+
+	list, err := secrets.Secrets("").List(metav1.ListOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list secrets: %v", err)
+	}
+	count := 0
+	for i := range list.Items {
+		secret := &list.Items[i]
+		_, err := secrets.Secrets(secret.Namespace).Update(secret)
+		switch {
+		case errors.IsNotFound(err) || errors.IsConflict(err):
+		case err != nil:
+			return count, fmt.Errorf("failed to rewrite secret %s/%s: %v", secret.Namespace, secret.Name, err)
+		default:
+			count++
+		}
+	}
+	return count, nil
+}
+
+type encryptionConfig struct {
+	Kind       string               `yaml:"kind"`
+	APIVersion string               `yaml:"apiVersion"`
+	Resources  []encryptionResource `yaml:"resources"`
+}
+
+type encryptionResource struct {
+	Resources []string        `yaml:"resources"`
+	Providers []yaml.MapSlice `yaml:"providers"`
+}
+
+type encryptionKeys struct {
+	Keys []v3.EncryptionKey `yaml:"keys"`
+}
+
+// EncryptionConfig renders the encryption provider config of kube-apiserver. The active key of the key
+// rotation is moved to the front of the keys of the first provider so that it encrypts new secrets.
+func EncryptionConfig(config *v3.SecretsEncryptionConfig) (string, error) {
+	if len(config.Providers) == 0 {
+		return "", fmt.Errorf("at least one encryption provider is required")
+	}
+
+	resource := encryptionResource{
+		Resources: []string{"secrets"},
+	}
+	for i, provider := range config.Providers {
+		if provider.Type == "identity" {
+			resource.Providers = append(resource.Providers, yaml.MapSlice{{Key: "identity", Value: struct{}{}}})
+			continue
+		}
+
+		keys := provider.Keys
+		if i == 0 && config.KeyRotation != nil && config.KeyRotation.ActiveKey != "" {
+			active := activeKeyFirst(keys, config.KeyRotation.ActiveKey)
+			if active == nil {
+				return "", fmt.Errorf("active key [%s] is not a key of the %s provider", config.KeyRotation.ActiveKey, provider.Type)
+			}
+			keys = active
+		}
+		resource.Providers = append(resource.Providers, yaml.MapSlice{{Key: provider.Type, Value: encryptionKeys{Keys: keys}}})
+	}
+
+	content, err := yaml.Marshal(encryptionConfig{
+		Kind:       "EncryptionConfig",
+		APIVersion: "v1",
+		Resources:  []encryptionResource{resource},
+	})
+	return string(content), err
+}
+
+// activeKeyFirst returns a copy of keys with the key called name first, nil if there is no such key
+func activeKeyFirst(keys []v3.EncryptionKey, name string) []v3.EncryptionKey {
+	for i, key := range keys {
+		if key.Name == name {
+			result := []v3.EncryptionKey{key}
+			result = append(result, keys[:i]...)
+			return append(result, keys[i+1:]...)
+		}
+	}
+	return nil
+}
+
+func argName(arg string) string {
+	return strings.TrimLeft(strings.SplitN(arg, "=", 2)[0], "-")
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func orDefault(value, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
+
+func intOrDefault(value, def int) int {
+	if value == 0 {
+		return def
+	}
+	return value
+}
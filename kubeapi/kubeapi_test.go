@@ -0,0 +1,194 @@
+package kubeapi
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	corev1 "github.com/rancher/types/apis/core/v1"
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// fakeSecrets implements the listing and updating of secrets, the other methods are not used
+type fakeSecrets struct {
+	corev1.SecretInterface
+	items    []v1.Secret
+	conflict string
+	updated  []string
+}
+
+func (f *fakeSecrets) Secrets(namespace string) corev1.SecretInterface {
+	return f
+}
+
+func (f *fakeSecrets) List(opts metav1.ListOptions) (*corev1.SecretList, error) {
+	return &corev1.SecretList{Items: f.items}, nil
+}
+
+func (f *fakeSecrets) Update(secret *v1.Secret) (*v1.Secret, error) {
+	if secret.Name == f.conflict {
+		return nil, errors.NewConflict(schema.GroupResource{Resource: "secrets"}, secret.Name, nil)
+	}
+	f.updated = append(f.updated, secret.Namespace+"/"+secret.Name)
+	return secret, nil
+}
+
+func TestRewriteSecrets(t *testing.T) {
+	secret := func(namespace, name string) v1.Secret {
+		return v1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+	svc := func(rewrite bool) v3.KubeAPIService {
+		return v3.KubeAPIService{SecretsEncryptionConfig: &v3.SecretsEncryptionConfig{
+			Enabled:     true,
+			KeyRotation: &v3.KeyRotation{ActiveKey: "new", RewriteSecrets: rewrite},
+		}}
+	}
+
+	secrets := &fakeSecrets{
+		items:    []v1.Secret{secret("default", "a"), secret("kube-system", "b"), secret("default", "changed")},
+		conflict: "changed",
+	}
+	count, err := RewriteSecrets(svc(true), secrets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(secrets.updated)
+	if expected := []string{"default/a", "kube-system/b"}; count != 2 || !reflect.DeepEqual(secrets.updated, expected) {
+		t.Errorf("expected %v to be rewritten, got %d: %v", expected, count, secrets.updated)
+	}
+
+	secrets = &fakeSecrets{items: []v1.Secret{secret("default", "a")}}
+	if count, err := RewriteSecrets(svc(false), secrets); err != nil || count != 0 || len(secrets.updated) != 0 {
+		t.Errorf("expected no secrets to be rewritten, got %d: %v, %v", count, secrets.updated, err)
+	}
+}
+
+func testService() v3.KubeAPIService {
+	return v3.KubeAPIService{
+		AuditLog: &v3.AuditLog{Enabled: true, Path: "/var/log/audit/kube.json", MaxAge: 7},
+		SecretsEncryptionConfig: &v3.SecretsEncryptionConfig{
+			Enabled: true,
+			Providers: []v3.EncryptionProvider{
+				{Type: "aescbc", Keys: []v3.EncryptionKey{{Name: "old", Secret: "b2xk"}, {Name: "new", Secret: "bmV3"}}},
+				{Type: "identity"},
+			},
+			KeyRotation: &v3.KeyRotation{ActiveKey: "new"},
+		},
+	}
+}
+
+func TestArgs(t *testing.T) {
+	expected := map[string]string{
+		"audit-policy-file":                       AuditPolicyFile,
+		"audit-log-path":                          "/var/log/audit/kube.json",
+		"audit-log-maxage":                        "7",
+		"audit-log-maxbackup":                     "10",
+		"audit-log-maxsize":                       "100",
+		"audit-log-format":                        "json",
+		"experimental-encryption-provider-config": EncryptionConfigFile,
+	}
+	if args := Args(testService()); !reflect.DeepEqual(args, expected) {
+		t.Errorf("expected args %v, got %v", expected, args)
+	}
+
+	disabled := testService()
+	disabled.AuditLog.Enabled = false
+	disabled.SecretsEncryptionConfig.Enabled = false
+	if args := Args(disabled); len(args) != 0 {
+		t.Errorf("expected no args when disabled, got %v", args)
+	}
+}
+
+func TestEncryptionConfig(t *testing.T) {
+	expected := `kind: EncryptionConfig
+apiVersion: v1
+resources:
+- resources:
+  - secrets
+  providers:
+  - aescbc:
+      keys:
+      - name: new
+        secret: bmV3
+      - name: old
+        secret: b2xk
+  - identity: {}
+`
+	config, err := EncryptionConfig(testService().SecretsEncryptionConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config != expected {
+		t.Errorf("expected the config\n%s\ngot\n%s", expected, config)
+	}
+
+	for _, invalid := range []*v3.SecretsEncryptionConfig{
+		{Enabled: true},
+		{Enabled: true, Providers: testService().SecretsEncryptionConfig.Providers, KeyRotation: &v3.KeyRotation{ActiveKey: "missing"}},
+	} {
+		if _, err := EncryptionConfig(invalid); err == nil {
+			t.Errorf("%+v: expected an error", invalid)
+		}
+	}
+}
+
+func TestAddToPlan(t *testing.T) {
+	plan := &v3.RKEConfigNodePlan{
+		Address: "10.0.0.1",
+		Processes: map[string]v3.Process{
+			ProcessName: {Name: ProcessName, Args: []string{"--audit-log-maxage=1"}, Binds: []string{"/etc/kubernetes:/etc/kubernetes:z"}},
+		},
+		Files: []v3.File{{Name: "/etc/kubernetes/other.yaml", Contents: "other"}},
+	}
+
+	svc := testService()
+	for i := 0; i < 2; i++ {
+		if err := AddToPlan(svc, plan); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	process := plan.Processes[ProcessName]
+	expectedArgs := []string{
+		"--audit-log-maxage=1",
+		"--audit-log-format=json",
+		"--audit-log-maxbackup=10",
+		"--audit-log-maxsize=100",
+		"--audit-log-path=/var/log/audit/kube.json",
+		"--audit-policy-file=" + AuditPolicyFile,
+		"--experimental-encryption-provider-config=" + EncryptionConfigFile,
+	}
+	if !reflect.DeepEqual(process.Args, expectedArgs) {
+		t.Errorf("expected args %v, got %v", expectedArgs, process.Args)
+	}
+	expectedBinds := []string{"/etc/kubernetes:/etc/kubernetes:z", "/var/log/audit:/var/log/audit:z"}
+	if !reflect.DeepEqual(process.Binds, expectedBinds) {
+		t.Errorf("expected binds %v, got %v", expectedBinds, process.Binds)
+	}
+
+	var names []string
+	for _, file := range plan.Files {
+		names = append(names, file.Name)
+	}
+	if expected := []string{"/etc/kubernetes/other.yaml", AuditPolicyFile, EncryptionConfigFile}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected files %v, got %v", expected, names)
+	}
+
+	// a rotated key replaces the encryption config already in the plan
+	svc.SecretsEncryptionConfig.KeyRotation.ActiveKey = "old"
+	if err := AddToPlan(svc, plan); err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Files) != 3 || !strings.Contains(plan.Files[2].Contents, "- name: old\n        secret: b2xk\n      - name: new") {
+		t.Errorf("expected the encryption config with the old key first, got %v", plan.Files)
+	}
+
+	if err := AddToPlan(svc, &v3.RKEConfigNodePlan{Address: "10.0.0.2"}); err == nil {
+		t.Error("expected an error for a plan without kube-apiserver")
+	}
+}
@@ -33,6 +33,8 @@ func main() {
 		v1.ConfigMap{},
 		v1.ServiceAccount{},
 		v1.ReplicationController{},
+		v1.ResourceQuota{},
+		v1.LimitRange{},
 	}, []interface{}{
 		v1.Node{},
 		v1.ComponentStatus{},
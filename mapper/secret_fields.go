@@ -0,0 +1,94 @@
+package mapper
+
+import (
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/norman/types/definition"
+)
+
+// SecretFields removes the values of every password field below Fields. It keeps credentials that were
+// resolved from secrets into a copy of a spec, such as Cluster.Status.AppliedSpec, from being returned.
+type SecretFields struct {
+	Fields []string
+	paths  [][]string
+}
+
+func (s *SecretFields) FromInternal(data map[string]interface{}) {
+	for _, path := range s.paths {
+		removePath(data, path)
+	}
+}
+
+func (s *SecretFields) ToInternal(data map[string]interface{}) {
+}
+
+func (s *SecretFields) ModifySchema(schema *types.Schema, schemas *types.Schemas) error {
+	s.paths = nil
+	for _, name := range s.Fields {
+		field, ok := schema.ResourceFields[name]
+		if !ok {
+			continue
+		}
+		s.paths = append(s.paths, passwordPaths([]string{name}, field.Type, &schema.Version, schemas, map[string]bool{})...)
+	}
+	return nil
+}
+
+// passwordPaths returns the paths of the password fields of fieldType, visited guards against recursive types
+func passwordPaths(prefix []string, fieldType string, version *types.APIVersion, schemas *types.Schemas, visited map[string]bool) [][]string {
+	if fieldType == "password" {
+		return [][]string{prefix}
+	}
+
+	if definition.IsArrayType(fieldType) {
+		prefix = append(prefix, convert.ArrayKey)
+		fieldType = definition.SubType(fieldType)
+	} else if definition.IsMapType(fieldType) {
+		prefix = append(prefix, convert.MapKey)
+		fieldType = definition.SubType(fieldType)
+	}
+
+	subSchema := schemas.Schema(version, fieldType)
+	if subSchema == nil || visited[subSchema.ID] {
+		return nil
+	}
+	visited[subSchema.ID] = true
+	defer delete(visited, subSchema.ID)
+
+	var result [][]string
+	for name, field := range subSchema.ResourceFields {
+		path := append(append([]string{}, prefix...), name)
+		result = append(result, passwordPaths(path, field.Type, version, schemas, visited)...)
+	}
+	return result
+}
+
+func removePath(data map[string]interface{}, path []string) {
+	if len(path) == 0 || data == nil {
+		return
+	}
+
+	key := path[0]
+	if len(path) == 1 {
+		delete(data, key)
+		return
+	}
+
+	value, ok := data[key]
+	if !ok || value == nil {
+		return
+	}
+
+	switch path[1] {
+	case convert.ArrayKey:
+		for _, item := range convert.ToMapSlice(value) {
+			removePath(item, path[2:])
+		}
+	case convert.MapKey:
+		for _, item := range convert.ToMapInterface(value) {
+			removePath(convert.ToMapInterface(item), path[2:])
+		}
+	default:
+		removePath(convert.ToMapInterface(value), path[1:])
+	}
+}
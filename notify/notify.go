@@ -0,0 +1,304 @@
+// Package notify sends the test notifications of the send actions of notifiers. Every notifier type except
+// SMTP is sent over HTTP, the endpoints of the services can be pointed at a local server with Sender.
+package notify
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/textproto"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+const (
+	// DefaultPagerdutyURL is the events API notifications are sent to
+	DefaultPagerdutyURL = "https://events.pagerduty.com/generic/2010-04-15/create_event.json"
+	// DefaultOpsgenieURL is used when the config of a notifier leaves the API URL empty
+	DefaultOpsgenieURL = "https://api.opsgenie.com/"
+	// OpsgenieMessageLength is the number of characters of the longest message opsgenie accepts, longer
+	// messages go in the description
+	OpsgenieMessageLength = 130
+
+	defaultTimeout = 15 * time.Second
+	maxErrorBody   = 512
+	smtpOK         = 250
+)
+
+// HTTPError is returned when the service answers with a status other than 2xx
+type HTTPError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("http status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("http status %d: %s", e.StatusCode, e.Body)
+}
+
+// Sender sends notifications, the zero value sends to the services
+type Sender struct {
+	// Client is used for every type except webhooks with a TLS config, which get a client of their own
+	Client *http.Client
+	// PagerdutyURL replaces DefaultPagerdutyURL
+	PagerdutyURL string
+}
+
+// Spec returns the notifier configs of notification
+func Spec(notification *v3.Notification) v3.NotifierSpec {
+	return v3.NotifierSpec{
+		SMTPConfig:      notification.SMTPConfig,
+		SlackConfig:     notification.SlackConfig,
+		PagerdutyConfig: notification.PagerdutyConfig,
+		WebhookConfig:   notification.WebhookConfig,
+		OpsgenieConfig:  notification.OpsgenieConfig,
+		MSTeamsConfig:   notification.MSTeamsConfig,
+	}
+}
+
+// Send sends the message of notification with the one notifier config it has. It returns the HTTP or SMTP
+// status of the response, 0 when no response was received.
+func (s *Sender) Send(notification *v3.Notification) (int, error) {
+	spec := Spec(notification)
+	if err := spec.Validate().Err(); err != nil {
+		return 0, err
+	}
+
+	message := notification.Message
+	switch {
+	case spec.SMTPConfig != nil:
+		return s.sendEmail(spec.SMTPConfig, message)
+	case spec.SlackConfig != nil:
+		return s.post(s.client(), spec.SlackConfig.URL, nil, map[string]string{
+			"text":    message,
+			"channel": spec.SlackConfig.DefaultRecipient,
+		})
+	case spec.PagerdutyConfig != nil:
+		return s.post(s.client(), or(s.PagerdutyURL, DefaultPagerdutyURL), nil, map[string]string{
+			"service_key": spec.PagerdutyConfig.ServiceKey,
+			"event_type":  "trigger",
+			"description": message,
+		})
+	case spec.WebhookConfig != nil:
+		return s.sendWebhook(spec.WebhookConfig, message)
+	case spec.OpsgenieConfig != nil:
+		return s.sendOpsgenie(spec.OpsgenieConfig, message)
+	case spec.MSTeamsConfig != nil:
+		return s.post(s.client(), spec.MSTeamsConfig.URL, nil, map[string]string{
+			"text": message,
+		})
+	}
+	return 0, nil
+}
+
+// WebhookBody returns the body a webhook is sent with, the body template is executed with the message as
+// .Message
+func WebhookBody(webhook *v3.WebhookConfig, message string) ([]byte, error) {
+	if webhook.BodyTemplate == "" {
+		return json.Marshal(map[string]string{"message": message})
+	}
+	tmpl, err := template.New("body").Option("missingkey=error").Parse(webhook.BodyTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid body template: %v", err)
+	}
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, map[string]interface{}{"Message": message}); err != nil {
+		return nil, fmt.Errorf("executing body template: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *Sender) sendWebhook(webhook *v3.WebhookConfig, message string) (int, error) {
+	body, err := WebhookBody(webhook, message)
+	if err != nil {
+		return 0, err
+	}
+
+	client := s.client()
+	if webhook.TLSConfig != nil {
+		if client, err = tlsClient(client, webhook.TLSConfig); err != nil {
+			return 0, err
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	if webhook.BodyTemplate == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for name, value := range webhook.Headers {
+		req.Header.Set(name, value)
+	}
+	if webhook.BasicAuth != nil {
+		req.SetBasicAuth(webhook.BasicAuth.Username, webhook.BasicAuth.Password)
+	}
+	if webhook.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+webhook.BearerToken)
+	}
+	return do(client, req)
+}
+
+func (s *Sender) sendOpsgenie(opsgenie *v3.OpsgenieConfig, message string) (int, error) {
+	alert := map[string]interface{}{
+		"message": message,
+	}
+	if runes := []rune(message); len(runes) > OpsgenieMessageLength {
+		alert["message"] = string(runes[:OpsgenieMessageLength])
+		alert["description"] = message
+	}
+	if opsgenie.DefaultRecipient != "" {
+		alert["responders"] = []map[string]string{{"name": opsgenie.DefaultRecipient, "type": "team"}}
+	}
+	if len(opsgenie.Tags) > 0 {
+		alert["tags"] = opsgenie.Tags
+	}
+
+	alertsURL := strings.TrimSuffix(or(opsgenie.APIURL, DefaultOpsgenieURL), "/") + "/v2/alerts"
+	return s.post(s.client(), alertsURL, map[string]string{"Authorization": "GenieKey " + opsgenie.APIKey}, alert)
+}
+
+// sendEmail returns the status of the reply to the last command, or of the failed command
+func (s *Sender) sendEmail(config *v3.SMTPConfig, message string) (int, error) {
+	err := s.email(config, message)
+	if err == nil {
+		return smtpOK, nil
+	}
+	if protocolErr, ok := err.(*textproto.Error); ok {
+		return protocolErr.Code, err
+	}
+	return 0, err
+}
+
+func (s *Sender) email(config *v3.SMTPConfig, message string) error {
+	addr := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+	c, err := smtp.Dial(addr)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if config.TLS {
+		if err := c.StartTLS(&tls.Config{ServerName: config.Host}); err != nil {
+			return err
+		}
+	}
+	if config.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", config.Username, config.Password, config.Host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(config.Sender); err != nil {
+		return err
+	}
+	if err := c.Rcpt(config.DefaultRecipient); err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	msg := "To: " + config.DefaultRecipient + "\r\n" +
+		"Subject: Rancher test notification\r\n" +
+		"\r\n" + message + "\r\n"
+	if _, err := io.WriteString(w, msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+func (s *Sender) post(client *http.Client, url string, headers map[string]string, payload interface{}) (int, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	return do(client, req)
+}
+
+func (s *Sender) client() *http.Client {
+	if s.Client != nil {
+		return s.Client
+	}
+	return &http.Client{Timeout: defaultTimeout}
+}
+
+func do(client *http.Client, req *http.Request) (int, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		if urlErr, ok := err.(*url.Error); ok {
+			// the URL of webhooks can hold a token, it is left out of the error
+			return 0, urlErr.Err
+		}
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return resp.StatusCode, &HTTPError{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(body))}
+	}
+	return resp.StatusCode, nil
+}
+
+// tlsClient returns a copy of client that connects with config
+func tlsClient(client *http.Client, config *v3.HTTPTLSConfig) (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         config.ServerName,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+	if config.CACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(config.CACert)) {
+			return nil, fmt.Errorf("no PEM encoded certificate found in caCert")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.ClientCert != "" {
+		cert, err := tls.X509KeyPair([]byte(config.ClientCert), []byte(config.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	c := *client
+	c.Transport = &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: tlsConfig,
+	}
+	return &c, nil
+}
+
+func or(value, fallback string) string {
+	if value != "" {
+		return value
+	}
+	return fallback
+}
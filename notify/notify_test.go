@@ -0,0 +1,220 @@
+package notify
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+// request is a request received by a test server
+type request struct {
+	method string
+	path   string
+	header http.Header
+	body   string
+}
+
+// newServer returns a server that answers with status and the requests it received
+func newServer(t *testing.T, tls bool, status int, answer string) (*httptest.Server, *[]request) {
+	var requests []request
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		requests = append(requests, request{method: r.Method, path: r.URL.Path, header: r.Header, body: string(body)})
+		w.WriteHeader(status)
+		w.Write([]byte(answer))
+	})
+	if tls {
+		return httptest.NewTLSServer(handler), &requests
+	}
+	return httptest.NewServer(handler), &requests
+}
+
+func decode(t *testing.T, body string) map[string]interface{} {
+	result := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(body), &result); err != nil {
+		t.Fatalf("invalid JSON body %q: %v", body, err)
+	}
+	return result
+}
+
+func send(t *testing.T, sender *Sender, notification *v3.Notification, requests *[]request) request {
+	code, err := sender.Send(notification)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", code)
+	}
+	if len(*requests) != 1 {
+		t.Fatalf("expected one request, got %d", len(*requests))
+	}
+	return (*requests)[0]
+}
+
+func TestSendSlack(t *testing.T) {
+	server, requests := newServer(t, false, http.StatusOK, "ok")
+	defer server.Close()
+
+	r := send(t, &Sender{}, &v3.Notification{
+		Message:     "disk full",
+		SlackConfig: &v3.SlackConfig{URL: server.URL + "/services/T0/B0/x", DefaultRecipient: "#alerts"},
+	}, requests)
+
+	if r.method != http.MethodPost || r.path != "/services/T0/B0/x" {
+		t.Errorf("unexpected request %s %s", r.method, r.path)
+	}
+	expected := map[string]interface{}{"text": "disk full", "channel": "#alerts"}
+	if body := decode(t, r.body); !reflect.DeepEqual(body, expected) {
+		t.Errorf("expected body %v, got %v", expected, body)
+	}
+}
+
+func TestSendPagerduty(t *testing.T) {
+	server, requests := newServer(t, false, http.StatusOK, "")
+	defer server.Close()
+
+	r := send(t, &Sender{PagerdutyURL: server.URL + "/create_event.json"}, &v3.Notification{
+		Message:         "disk full",
+		PagerdutyConfig: &v3.PagerdutyConfig{ServiceKey: "key"},
+	}, requests)
+
+	if r.path != "/create_event.json" {
+		t.Errorf("unexpected path %s", r.path)
+	}
+	expected := map[string]interface{}{"service_key": "key", "event_type": "trigger", "description": "disk full"}
+	if body := decode(t, r.body); !reflect.DeepEqual(body, expected) {
+		t.Errorf("expected body %v, got %v", expected, body)
+	}
+}
+
+func TestSendWebhook(t *testing.T) {
+	server, requests := newServer(t, true, http.StatusOK, "")
+	defer server.Close()
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	r := send(t, &Sender{}, &v3.Notification{
+		Message: `disk "full"`,
+		WebhookConfig: &v3.WebhookConfig{
+			URL:          server.URL + "/hook",
+			Headers:      map[string]string{"X-Source": "rancher", "Content-Type": "text/plain"},
+			BasicAuth:    &v3.HTTPBasicAuth{Username: "user", Password: "secret"},
+			TLSConfig:    &v3.HTTPTLSConfig{CACert: string(caCert)},
+			BodyTemplate: `alert: {{.Message}}`,
+		},
+	}, requests)
+
+	if r.path != "/hook" || r.body != `alert: disk "full"` {
+		t.Errorf("unexpected request to %s with body %q", r.path, r.body)
+	}
+	if r.header.Get("X-Source") != "rancher" || r.header.Get("Content-Type") != "text/plain" {
+		t.Errorf("expected the headers of the config, got %v", r.header)
+	}
+	if auth := r.header.Get("Authorization"); auth != "Basic dXNlcjpzZWNyZXQ=" {
+		t.Errorf("unexpected authorization %q", auth)
+	}
+
+	server, requests = newServer(t, false, http.StatusOK, "")
+	defer server.Close()
+	r = send(t, &Sender{}, &v3.Notification{
+		Message:       "disk full",
+		WebhookConfig: &v3.WebhookConfig{URL: server.URL, BearerToken: "token"},
+	}, requests)
+
+	if auth := r.header.Get("Authorization"); auth != "Bearer token" {
+		t.Errorf("unexpected authorization %q", auth)
+	}
+	if r.header.Get("Content-Type") != "application/json" || r.body != `{"message":"disk full"}` {
+		t.Errorf("unexpected body %q of type %s", r.body, r.header.Get("Content-Type"))
+	}
+}
+
+func TestSendWebhookUnknownAuthority(t *testing.T) {
+	server, _ := newServer(t, true, http.StatusOK, "")
+	defer server.Close()
+
+	code, err := (&Sender{}).Send(&v3.Notification{
+		Message:       "disk full",
+		WebhookConfig: &v3.WebhookConfig{URL: server.URL},
+	})
+	if code != 0 || err == nil {
+		t.Errorf("expected the certificate of the server to be rejected, got %d, %v", code, err)
+	}
+}
+
+func TestSendOpsgenie(t *testing.T) {
+	server, requests := newServer(t, false, http.StatusAccepted, "")
+	defer server.Close()
+
+	// every character is two bytes, cutting by bytes would split one
+	message := strings.Repeat("é", OpsgenieMessageLength+1)
+	code, err := (&Sender{}).Send(&v3.Notification{
+		Message: message,
+		OpsgenieConfig: &v3.OpsgenieConfig{
+			APIKey:           "key",
+			APIURL:           server.URL + "/",
+			DefaultRecipient: "ops",
+			Tags:             []string{"rancher"},
+		},
+	})
+	if err != nil || code != http.StatusAccepted {
+		t.Fatalf("expected status 202, got %d, %v", code, err)
+	}
+
+	r := (*requests)[0]
+	if r.path != "/v2/alerts" || r.header.Get("Authorization") != "GenieKey key" {
+		t.Errorf("unexpected request to %s with authorization %q", r.path, r.header.Get("Authorization"))
+	}
+	expected := map[string]interface{}{
+		"message":     strings.Repeat("é", OpsgenieMessageLength),
+		"description": message,
+		"responders":  []interface{}{map[string]interface{}{"name": "ops", "type": "team"}},
+		"tags":        []interface{}{"rancher"},
+	}
+	if body := decode(t, r.body); !reflect.DeepEqual(body, expected) {
+		t.Errorf("expected body %v, got %v", expected, body)
+	}
+}
+
+func TestSendMSTeams(t *testing.T) {
+	server, requests := newServer(t, false, http.StatusOK, "1")
+	defer server.Close()
+
+	r := send(t, &Sender{}, &v3.Notification{
+		Message:       "disk full",
+		MSTeamsConfig: &v3.MSTeamsConfig{URL: server.URL + "/webhookb2/x"},
+	}, requests)
+
+	if r.path != "/webhookb2/x" || r.body != `{"text":"disk full"}` {
+		t.Errorf("unexpected request to %s with body %q", r.path, r.body)
+	}
+}
+
+func TestSendHTTPError(t *testing.T) {
+	server, _ := newServer(t, false, http.StatusForbidden, "invalid_token\n")
+	defer server.Close()
+
+	code, err := (&Sender{}).Send(&v3.Notification{
+		Message:     "disk full",
+		SlackConfig: &v3.SlackConfig{URL: server.URL, DefaultRecipient: "#alerts"},
+	})
+	if code != http.StatusForbidden {
+		t.Errorf("expected status 403, got %d", code)
+	}
+	httpErr, ok := err.(*HTTPError)
+	if !ok {
+		t.Fatalf("expected an *HTTPError, got %T: %v", err, err)
+	}
+	if *httpErr != (HTTPError{StatusCode: http.StatusForbidden, Body: "invalid_token"}) {
+		t.Errorf("unexpected error %+v", httpErr)
+	}
+}
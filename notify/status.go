@@ -0,0 +1,79 @@
+package notify
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/rancher/norman/condition"
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+// MaxSendHistory is the number of attempts kept in the status of a notifier
+const MaxSendHistory = 10
+
+// smtpAuthFailed is the reply of SMTP servers to rejected credentials
+const smtpAuthFailed = 535
+
+// Record adds the result of a send to the status of notifier and sets its conditions. Source is the alert
+// the notification was sent for, code and err are the results of Sender.Send.
+func Record(notifier *v3.Notifier, source string, code int, err error, now time.Time) {
+	attempt := v3.NotifierSendAttempt{
+		Time:         now.UTC().Format(time.RFC3339),
+		Source:       source,
+		Success:      err == nil,
+		ResponseCode: code,
+	}
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+
+	status := &notifier.Status
+	status.LastSend = &attempt
+	status.SendHistory = append([]v3.NotifierSendAttempt{attempt}, status.SendHistory...)
+	if len(status.SendHistory) > MaxSendHistory {
+		status.SendHistory = status.SendHistory[:MaxSendHistory]
+	}
+
+	switch {
+	case err == nil:
+		setCondition(notifier, v3.NotifierConditionReachable, nil)
+		setCondition(notifier, v3.NotifierConditionAuthenticated, nil)
+	case authFailed(code):
+		setCondition(notifier, v3.NotifierConditionReachable, nil)
+		setCondition(notifier, v3.NotifierConditionAuthenticated, err)
+	case unreachable(code, err):
+		setCondition(notifier, v3.NotifierConditionReachable, err)
+	case code != 0:
+		// the service answered, it rejected the notification for a reason the conditions do not cover
+		setCondition(notifier, v3.NotifierConditionReachable, nil)
+	}
+}
+
+func setCondition(notifier *v3.Notifier, cond condition.Cond, err error) {
+	if err == nil {
+		cond.True(notifier)
+		cond.Reason(notifier, "")
+		cond.Message(notifier, "")
+		return
+	}
+	cond.False(notifier)
+	cond.ReasonAndMessageFromError(notifier, err)
+}
+
+func authFailed(code int) bool {
+	return code == http.StatusUnauthorized || code == http.StatusForbidden || code == smtpAuthFailed
+}
+
+// unreachable is true for errors without a response, and for the statuses of proxies and gateways that could
+// not reach the service
+func unreachable(code int, err error) bool {
+	switch code {
+	case 0:
+		_, ok := err.(net.Error)
+		return ok
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,220 @@
+package notify
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+// Alert holds the fields of an alert a message template can use
+type Alert struct {
+	// ID is the namespace and name of the alert
+	ID          string
+	DisplayName string
+	Description string
+	Severity    string
+	// Type is the kind of the target: metric, node, systemService, event, pod or workload
+	Type        string
+	Target      string
+	ClusterName string
+	ProjectName string
+	// FiringTime is the RFC3339 time the alert started to fire
+	FiringTime string
+	// Message describes the condition that fired, for example the value of the metric
+	Message string
+}
+
+// SampleAlert is the alert templates are validated and previewed with
+var SampleAlert = Alert{
+	ID:          "c-sample:alert-sample",
+	DisplayName: "High memory usage",
+	Description: "The memory usage of a node is above the threshold",
+	Severity:    "critical",
+	Type:        "node",
+	Target:      "node sample-node",
+	ClusterName: "c-sample",
+	ProjectName: "",
+	FiringTime:  "2018-06-01T12:00:00Z",
+	Message:     "the memory usage of node sample-node is 93%",
+}
+
+// DefaultTemplates are the templates of the notifier types, keyed by the notifier types of recipients
+var DefaultTemplates = map[string]string{
+	"email": `[{{.Severity}}] {{.DisplayName}}
+
+{{.Message}}
+
+Target: {{.Target}}
+Cluster: {{.ClusterName}}
+{{- if .ProjectName}}
+Project: {{.ProjectName}}
+{{- end}}
+Firing since: {{.FiringTime}}
+{{- if .Description}}
+
+{{.Description}}
+{{- end}}
+`,
+	"slack": `*[{{.Severity}}] {{.DisplayName}}*
+{{.Message}}
+Target: {{.Target}}, cluster: {{.ClusterName}}{{if .ProjectName}}, project: {{.ProjectName}}{{end}}, firing since {{.FiringTime}}`,
+	"pagerduty": `[{{.Severity}}] {{.DisplayName}}: {{.Message}}`,
+	"webhook":   `[{{.Severity}}] {{.DisplayName}}: {{.Message}} ({{.Target}}, firing since {{.FiringTime}})`,
+	"opsgenie":  `[{{.Severity}}] {{.DisplayName}}: {{.Message}}`,
+	"msteams": `**[{{.Severity}}] {{.DisplayName}}**
+
+{{.Message}}
+
+Target: {{.Target}}, cluster: {{.ClusterName}}{{if .ProjectName}}, project: {{.ProjectName}}{{end}}, firing since {{.FiringTime}}`,
+}
+
+// NotifierType returns the type of the config of spec, as set on recipients
+func NotifierType(spec v3.NotifierSpec) string {
+	switch {
+	case spec.SMTPConfig != nil:
+		return "email"
+	case spec.SlackConfig != nil:
+		return "slack"
+	case spec.PagerdutyConfig != nil:
+		return "pagerduty"
+	case spec.WebhookConfig != nil:
+		return "webhook"
+	case spec.OpsgenieConfig != nil:
+		return "opsgenie"
+	case spec.MSTeamsConfig != nil:
+		return "msteams"
+	}
+	return ""
+}
+
+// MessageTemplate returns the template of the notifications of alert sent by notifier, the template of the
+// alert replaces the template of the notifier, which replaces the default of its type
+func MessageTemplate(alert v3.AlertCommonSpec, notifier v3.NotifierSpec) string {
+	if alert.MessageTemplate != "" {
+		return alert.MessageTemplate
+	}
+	if notifier.MessageTemplate != "" {
+		return notifier.MessageTemplate
+	}
+	return DefaultTemplates[NotifierType(notifier)]
+}
+
+// RenderMessage executes text with the fields of alert
+func RenderMessage(text string, alert Alert) (string, error) {
+	tmpl, err := parse(text)
+	if err != nil {
+		return "", err
+	}
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, alert); err != nil {
+		return "", fmt.Errorf("executing message template: %v", err)
+	}
+	return buf.String(), nil
+}
+
+// ValidateTemplate renders text with SampleAlert, which fails on fields an alert does not have
+func ValidateTemplate(text string) error {
+	_, err := RenderMessage(text, SampleAlert)
+	return err
+}
+
+// Preview renders the template of input with SampleAlert, the default template of the notifier type is
+// used when input has none
+func Preview(input v3.MessageTemplatePreviewInput) (v3.MessageTemplatePreviewOutput, error) {
+	text := input.MessageTemplate
+	if text == "" {
+		var ok bool
+		if text, ok = DefaultTemplates[input.NotifierType]; !ok {
+			return v3.MessageTemplatePreviewOutput{}, fmt.Errorf("a message template or a notifier type is required")
+		}
+	}
+	message, err := RenderMessage(text, SampleAlert)
+	return v3.MessageTemplatePreviewOutput{Message: message}, err
+}
+
+// ClusterAlertFields returns the fields of alert known before it fires
+func ClusterAlertFields(alert *v3.ClusterAlert) Alert {
+	spec := alert.Spec
+	fields := commonFields(alert.Namespace, alert.Name, spec.AlertCommonSpec)
+	fields.ClusterName = spec.ClusterName
+	switch {
+	case spec.TargetMetric != nil:
+		fields.Type = "metric"
+		fields.Target = spec.TargetMetric.Expression
+	case spec.TargetNode != nil:
+		fields.Type = "node"
+		fields.Target = nodeTarget(spec.TargetNode)
+	case spec.TargetSystemService != nil:
+		fields.Type = "systemService"
+		fields.Target = "system service " + spec.TargetSystemService.Condition
+	case spec.TargetEvent != nil:
+		fields.Type = "event"
+		fields.Target = fmt.Sprintf("%s events of %s", spec.TargetEvent.EventType, spec.TargetEvent.ResourceKind)
+	}
+	return fields
+}
+
+// ProjectAlertFields returns the fields of alert known before it fires
+func ProjectAlertFields(alert *v3.ProjectAlert) Alert {
+	spec := alert.Spec
+	fields := commonFields(alert.Namespace, alert.Name, spec.AlertCommonSpec)
+	fields.ProjectName = spec.ProjectName
+	if i := strings.Index(spec.ProjectName, ":"); i > 0 {
+		fields.ClusterName = spec.ProjectName[:i]
+	}
+	switch {
+	case spec.TargetMetric != nil:
+		fields.Type = "metric"
+		fields.Target = spec.TargetMetric.Expression
+	case spec.TargetPod != nil:
+		fields.Type = "pod"
+		fields.Target = "pod " + spec.TargetPod.PodName
+	case spec.TargetWorkload != nil:
+		fields.Type = "workload"
+		fields.Target = "workload " + spec.TargetWorkload.WorkloadID
+		if spec.TargetWorkload.WorkloadID == "" {
+			fields.Target = "workloads matching " + selector(spec.TargetWorkload.Selector)
+		}
+	}
+	return fields
+}
+
+func commonFields(namespace, name string, common v3.AlertCommonSpec) Alert {
+	return Alert{
+		ID:          namespace + ":" + name,
+		DisplayName: common.DisplayName,
+		Description: common.Description,
+		Severity:    common.Severity,
+	}
+}
+
+func nodeTarget(target *v3.TargetNode) string {
+	if target.NodeName != "" {
+		return "node " + target.NodeName
+	}
+	if len(target.Selector) > 0 {
+		return "nodes matching " + selector(target.Selector)
+	}
+	return "all nodes"
+}
+
+func selector(labels map[string]string) string {
+	var pairs []string
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func parse(text string) (*template.Template, error) {
+	tmpl, err := template.New("message").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message template: %v", err)
+	}
+	return tmpl, nil
+}
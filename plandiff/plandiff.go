@@ -0,0 +1,220 @@
+package plandiff
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionRemove = "remove"
+)
+
+// Diff compares the current plan with the desired plan and returns the changes of every node that will
+// change, ordered by address. Nodes are matched by address and processes by name. Environment values and
+// registry credentials are never included in the result, only the names of the changed variables.
+func Diff(current, desired *v3.RKEPlan) []v3.NodePlanChange {
+	currentNodes := nodesByAddress(current)
+	desiredNodes := nodesByAddress(desired)
+
+	var result []v3.NodePlanChange
+	for _, address := range sortedKeys(currentNodes, desiredNodes) {
+		oldNode, inCurrent := currentNodes[address]
+		newNode, inDesired := desiredNodes[address]
+
+		change := DiffNode(oldNode, newNode)
+		switch {
+		case !inCurrent:
+			change.Action = ActionCreate
+		case !inDesired:
+			change.Action = ActionRemove
+		case len(change.Processes) == 0 && len(change.AddedFiles) == 0 && len(change.ChangedFiles) == 0 && len(change.RemovedFiles) == 0:
+			continue
+		default:
+			change.Action = ActionUpdate
+		}
+		change.Address = address
+		result = append(result, change)
+	}
+
+	return result
+}
+
+// DiffNode compares the processes and files of a single node, the action of the result is not set
+func DiffNode(current, desired v3.RKEConfigNodePlan) v3.NodePlanChange {
+	change := v3.NodePlanChange{
+		Address: desired.Address,
+	}
+	if change.Address == "" {
+		change.Address = current.Address
+	}
+
+	for _, name := range sortedKeys(current.Processes, desired.Processes) {
+		oldProcess, inCurrent := current.Processes[name]
+		newProcess, inDesired := desired.Processes[name]
+		switch {
+		case !inCurrent:
+			change.Processes = append(change.Processes, v3.ProcessChange{Name: name, Action: ActionCreate})
+		case !inDesired:
+			change.Processes = append(change.Processes, v3.ProcessChange{Name: name, Action: ActionRemove})
+		default:
+			if fields := DiffProcess(oldProcess, newProcess); len(fields) > 0 {
+				change.Processes = append(change.Processes, v3.ProcessChange{Name: name, Action: ActionUpdate, Fields: fields})
+			}
+		}
+	}
+
+	currentFiles := filesByName(current.Files)
+	desiredFiles := filesByName(desired.Files)
+	for _, name := range sortedKeys(currentFiles, desiredFiles) {
+		oldContents, inCurrent := currentFiles[name]
+		newContents, inDesired := desiredFiles[name]
+		switch {
+		case !inCurrent:
+			change.AddedFiles = append(change.AddedFiles, name)
+		case !inDesired:
+			change.RemovedFiles = append(change.RemovedFiles, name)
+		case oldContents != newContents:
+			change.ChangedFiles = append(change.ChangedFiles, name)
+		}
+	}
+
+	return change
+}
+
+// DiffProcess returns the fields that differ between two versions of a process. Any difference means the
+// container of the process is recreated.
+func DiffProcess(current, desired v3.Process) []v3.ProcessFieldChange {
+	var result []v3.ProcessFieldChange
+
+	value := func(field, old, new string) {
+		if old != new {
+			result = append(result, v3.ProcessFieldChange{Field: field, Old: old, New: new})
+		}
+	}
+	list := func(field string, old, new []string) {
+		if change, ok := diffList(field, old, new); ok {
+			result = append(result, change)
+		}
+	}
+
+	value("image", current.Image, desired.Image)
+	if !reflect.DeepEqual(current.Command, desired.Command) {
+		value("command", strings.Join(current.Command, " "), strings.Join(desired.Command, " "))
+	}
+	list("args", current.Args, desired.Args)
+	if oldEnv, newEnv := envNames(current.Env), envNames(desired.Env); !reflect.DeepEqual(oldEnv, newEnv) {
+		list("env", oldEnv, newEnv)
+	} else if !reflect.DeepEqual(current.Env, desired.Env) {
+		result = append(result, v3.ProcessFieldChange{Field: "env", New: "values changed"})
+	}
+	list("binds", current.Binds, desired.Binds)
+	list("volumesFrom", current.VolumesFrom, desired.VolumesFrom)
+	value("networkMode", current.NetworkMode, desired.NetworkMode)
+	value("restartPolicy", current.RestartPolicy, desired.RestartPolicy)
+	value("pidMode", current.PidMode, desired.PidMode)
+	value("privileged", strconv.FormatBool(current.Privileged), strconv.FormatBool(desired.Privileged))
+	value("healthCheck", current.HealthCheck.URL, desired.HealthCheck.URL)
+	list("labels", mapEntries(current.Labels), mapEntries(desired.Labels))
+	if current.ImageRegistryAuthConfig != desired.ImageRegistryAuthConfig {
+		result = append(result, v3.ProcessFieldChange{Field: "imageRegistryAuthConfig", New: "credentials changed"})
+	}
+
+	return result
+}
+
+// diffList reports the entries added and removed, a pure reordering is reported as such because the
+// order of arguments matters to most processes
+func diffList(field string, old, new []string) (v3.ProcessFieldChange, bool) {
+	if reflect.DeepEqual(old, new) || (len(old) == 0 && len(new) == 0) {
+		return v3.ProcessFieldChange{}, false
+	}
+
+	change := v3.ProcessFieldChange{Field: field}
+	oldCount := count(old)
+	newCount := count(new)
+	for _, item := range new {
+		if oldCount[item] > 0 {
+			oldCount[item]--
+		} else {
+			change.Added = append(change.Added, item)
+		}
+	}
+	for _, item := range old {
+		if newCount[item] > 0 {
+			newCount[item]--
+		} else {
+			change.Removed = append(change.Removed, item)
+		}
+	}
+	if len(change.Added) == 0 && len(change.Removed) == 0 {
+		change.New = "order changed"
+	}
+	return change, true
+}
+
+func count(items []string) map[string]int {
+	result := map[string]int{}
+	for _, item := range items {
+		result[item]++
+	}
+	return result
+}
+
+func envNames(env []string) []string {
+	var result []string
+	for _, e := range env {
+		result = append(result, strings.SplitN(e, "=", 2)[0])
+	}
+	return result
+}
+
+func mapEntries(data map[string]string) []string {
+	var result []string
+	for k, v := range data {
+		result = append(result, k+"="+v)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func nodesByAddress(plan *v3.RKEPlan) map[string]v3.RKEConfigNodePlan {
+	result := map[string]v3.RKEConfigNodePlan{}
+	if plan == nil {
+		return result
+	}
+	for _, node := range plan.Nodes {
+		result[node.Address] = node
+	}
+	return result
+}
+
+func filesByName(files []v3.File) map[string]string {
+	result := map[string]string{}
+	for _, file := range files {
+		result[file.Name] = file.Contents
+	}
+	return result
+}
+
+// sortedKeys returns the union of the keys of two maps with string keys in sorted order
+func sortedKeys(a, b interface{}) []string {
+	seen := map[string]bool{}
+	for _, m := range []interface{}{a, b} {
+		for _, key := range reflect.ValueOf(m).MapKeys() {
+			seen[key.String()] = true
+		}
+	}
+
+	var result []string
+	for key := range seen {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
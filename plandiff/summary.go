@@ -0,0 +1,87 @@
+package plandiff
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+var actionSymbols = map[string]string{
+	ActionCreate: "+",
+	ActionUpdate: "~",
+	ActionRemove: "-",
+}
+
+// Summary renders changes as human readable text, one block per node. Updated processes are marked as
+// restarted since their containers are recreated.
+func Summary(changes []v3.NodePlanChange) string {
+	if len(changes) == 0 {
+		return "no changes\n"
+	}
+
+	buf := &bytes.Buffer{}
+	for _, node := range changes {
+		fmt.Fprintf(buf, "%s node %s\n", actionSymbols[node.Action], node.Address)
+
+		for _, process := range node.Processes {
+			switch process.Action {
+			case ActionCreate:
+				fmt.Fprintf(buf, "  + %s (start)\n", process.Name)
+			case ActionRemove:
+				fmt.Fprintf(buf, "  - %s (stop)\n", process.Name)
+			default:
+				fmt.Fprintf(buf, "  ~ %s (restart)\n", process.Name)
+			}
+			for _, field := range process.Fields {
+				writeField(buf, field)
+			}
+		}
+
+		writeFiles(buf, "+", node.AddedFiles)
+		writeFiles(buf, "~", node.ChangedFiles)
+		writeFiles(buf, "-", node.RemovedFiles)
+	}
+
+	return buf.String()
+}
+
+// Restarts returns the names of the processes that will be recreated, keyed by node address
+func Restarts(changes []v3.NodePlanChange) map[string][]string {
+	result := map[string][]string{}
+	for _, node := range changes {
+		for _, process := range node.Processes {
+			if process.Action == ActionUpdate {
+				result[node.Address] = append(result[node.Address], process.Name)
+			}
+		}
+	}
+	return result
+}
+
+func writeField(buf *bytes.Buffer, field v3.ProcessFieldChange) {
+	if len(field.Added) == 0 && len(field.Removed) == 0 {
+		if field.Old == "" {
+			fmt.Fprintf(buf, "      %s: %s\n", field.Field, field.New)
+		} else {
+			fmt.Fprintf(buf, "      %s: %s -> %s\n", field.Field, field.Old, field.New)
+		}
+		return
+	}
+
+	fmt.Fprintf(buf, "      %s:\n", field.Field)
+	for _, item := range field.Added {
+		fmt.Fprintf(buf, "        + %s\n", item)
+	}
+	for _, item := range field.Removed {
+		fmt.Fprintf(buf, "        - %s\n", item)
+	}
+}
+
+func writeFiles(buf *bytes.Buffer, symbol string, files []string) {
+	if len(files) == 0 {
+		return
+	}
+	fmt.Fprintf(buf, "  %s files: %s\n", symbol, strings.Join(files, ", "))
+}
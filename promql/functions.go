@@ -0,0 +1,101 @@
+package promql
+
+import (
+	"fmt"
+)
+
+type function struct {
+	args []ValueType
+	// optional is the number of trailing args that may be left out, -1 when the last arg repeats
+	optional int
+	returns  ValueType
+}
+
+var (
+	scalar = ValueTypeScalar
+	vector = ValueTypeVector
+	matrix = ValueTypeMatrix
+	str    = ValueTypeString
+
+	functions = map[string]function{
+		"abs":                {[]ValueType{vector}, 0, vector},
+		"absent":             {[]ValueType{vector}, 0, vector},
+		"avg_over_time":      {[]ValueType{matrix}, 0, vector},
+		"ceil":               {[]ValueType{vector}, 0, vector},
+		"changes":            {[]ValueType{matrix}, 0, vector},
+		"clamp_max":          {[]ValueType{vector, scalar}, 0, vector},
+		"clamp_min":          {[]ValueType{vector, scalar}, 0, vector},
+		"count_over_time":    {[]ValueType{matrix}, 0, vector},
+		"day_of_month":       {[]ValueType{vector}, 1, vector},
+		"day_of_week":        {[]ValueType{vector}, 1, vector},
+		"days_in_month":      {[]ValueType{vector}, 1, vector},
+		"delta":              {[]ValueType{matrix}, 0, vector},
+		"deriv":              {[]ValueType{matrix}, 0, vector},
+		"exp":                {[]ValueType{vector}, 0, vector},
+		"floor":              {[]ValueType{vector}, 0, vector},
+		"histogram_quantile": {[]ValueType{scalar, vector}, 0, vector},
+		"holt_winters":       {[]ValueType{matrix, scalar, scalar}, 0, vector},
+		"hour":               {[]ValueType{vector}, 1, vector},
+		"idelta":             {[]ValueType{matrix}, 0, vector},
+		"increase":           {[]ValueType{matrix}, 0, vector},
+		"irate":              {[]ValueType{matrix}, 0, vector},
+		"label_join":         {[]ValueType{vector, str, str, str}, -1, vector},
+		"label_replace":      {[]ValueType{vector, str, str, str, str}, 0, vector},
+		"ln":                 {[]ValueType{vector}, 0, vector},
+		"log10":              {[]ValueType{vector}, 0, vector},
+		"log2":               {[]ValueType{vector}, 0, vector},
+		"max_over_time":      {[]ValueType{matrix}, 0, vector},
+		"min_over_time":      {[]ValueType{matrix}, 0, vector},
+		"minute":             {[]ValueType{vector}, 1, vector},
+		"month":              {[]ValueType{vector}, 1, vector},
+		"predict_linear":     {[]ValueType{matrix, scalar}, 0, vector},
+		"quantile_over_time": {[]ValueType{scalar, matrix}, 0, vector},
+		"rate":               {[]ValueType{matrix}, 0, vector},
+		"resets":             {[]ValueType{matrix}, 0, vector},
+		"round":              {[]ValueType{vector, scalar}, 1, vector},
+		"scalar":             {[]ValueType{vector}, 0, scalar},
+		"sort":               {[]ValueType{vector}, 0, vector},
+		"sort_desc":          {[]ValueType{vector}, 0, vector},
+		"sqrt":               {[]ValueType{vector}, 0, vector},
+		"stddev_over_time":   {[]ValueType{matrix}, 0, vector},
+		"stdvar_over_time":   {[]ValueType{matrix}, 0, vector},
+		"sum_over_time":      {[]ValueType{matrix}, 0, vector},
+		"time":               {nil, 0, scalar},
+		"timestamp":          {[]ValueType{vector}, 0, vector},
+		"vector":             {[]ValueType{scalar}, 0, vector},
+		"year":               {[]ValueType{vector}, 1, vector},
+	}
+)
+
+// check compares the types of the arguments of a call with the signature of f
+func (f function) check(name string, args []ValueType) error {
+	min := len(f.args)
+	max := len(f.args)
+	switch {
+	case f.optional < 0:
+		max = -1
+	default:
+		min -= f.optional
+	}
+
+	if len(args) < min || (max >= 0 && len(args) > max) {
+		switch {
+		case max < 0:
+			return fmt.Errorf("expected at least %d argument(s) in call to %q, got %d", min, name, len(args))
+		case min == max:
+			return fmt.Errorf("expected %d argument(s) in call to %q, got %d", min, name, len(args))
+		}
+		return fmt.Errorf("expected %d to %d arguments in call to %q, got %d", min, max, name, len(args))
+	}
+
+	for i, arg := range args {
+		expected := f.args[len(f.args)-1]
+		if i < len(f.args) {
+			expected = f.args[i]
+		}
+		if arg != expected {
+			return fmt.Errorf("expected type %s in call to function %q, got %s", expected, name, arg)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package promql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenIdentifier
+	tokenNumber
+	tokenDuration
+	tokenString
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenLeftBrace
+	tokenRightBrace
+	tokenLeftBracket
+	tokenRightBracket
+	tokenComma
+	tokenMatchOp
+)
+
+var durationRegexp = regexp.MustCompile(`^[0-9]+(ms|[smhdwy])$`)
+
+type token struct {
+	typ   tokenType
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	if t.typ == tokenEOF {
+		return "end of input"
+	}
+	return fmt.Sprintf("%q", t.value)
+}
+
+// lex splits expr into tokens, comments are dropped
+func lex(expr string) ([]token, error) {
+	var result []token
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+		emit := func(typ tokenType, end int) {
+			result = append(result, token{typ: typ, value: string(runes[start:end]), pos: start})
+			i = end
+		}
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '#':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case isIdentifierStart(r):
+			end := i + 1
+			for end < len(runes) && isIdentifierChar(runes[end]) {
+				end++
+			}
+			emit(tokenIdentifier, end)
+		case isDigit(r) || (r == '.' && i+1 < len(runes) && isDigit(runes[i+1])):
+			end := scanNumber(runes, i)
+			if end < len(runes) && unicode.IsLetter(runes[end]) {
+				unit := end
+				for unit < len(runes) && unicode.IsLetter(runes[unit]) {
+					unit++
+				}
+				if !durationRegexp.MatchString(string(runes[i:unit])) {
+					return nil, fmt.Errorf("invalid number or duration %q at position %d", string(runes[i:unit]), i)
+				}
+				emit(tokenDuration, unit)
+				continue
+			}
+			emit(tokenNumber, end)
+		case r == '"' || r == '\'' || r == '`':
+			end, err := scanString(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			emit(tokenString, end)
+		case r == '(':
+			emit(tokenLeftParen, i+1)
+		case r == ')':
+			emit(tokenRightParen, i+1)
+		case r == '{':
+			emit(tokenLeftBrace, i+1)
+		case r == '}':
+			emit(tokenRightBrace, i+1)
+		case r == '[':
+			emit(tokenLeftBracket, i+1)
+		case r == ']':
+			emit(tokenRightBracket, i+1)
+		case r == ',':
+			emit(tokenComma, i+1)
+		case r == '=' && next(runes, i) == '~', r == '!' && next(runes, i) == '~':
+			emit(tokenMatchOp, i+2)
+		case r == '=' && next(runes, i) == '=', r == '!' && next(runes, i) == '=',
+			r == '<' && next(runes, i) == '=', r == '>' && next(runes, i) == '=':
+			emit(tokenOperator, i+2)
+		case r == '=':
+			emit(tokenMatchOp, i+1)
+		case strings.ContainsRune("+-*/%^<>", r):
+			emit(tokenOperator, i+1)
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", r, i)
+		}
+	}
+	return append(result, token{typ: tokenEOF, pos: len(runes)}), nil
+}
+
+// scanNumber returns the end of the decimal, hexadecimal or scientific number starting at i
+func scanNumber(runes []rune, i int) int {
+	if runes[i] == '0' && (next(runes, i) == 'x' || next(runes, i) == 'X') {
+		i += 2
+		for i < len(runes) && strings.ContainsRune("0123456789abcdefABCDEF", runes[i]) {
+			i++
+		}
+		return i
+	}
+
+	for i < len(runes) && isDigit(runes[i]) {
+		i++
+	}
+	if i < len(runes) && runes[i] == '.' {
+		i++
+		for i < len(runes) && isDigit(runes[i]) {
+			i++
+		}
+	}
+	if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+		exp := i + 1
+		if exp < len(runes) && (runes[exp] == '+' || runes[exp] == '-') {
+			exp++
+		}
+		if exp < len(runes) && isDigit(runes[exp]) {
+			i = exp
+			for i < len(runes) && isDigit(runes[i]) {
+				i++
+			}
+		}
+	}
+	return i
+}
+
+// scanString returns the end of the quoted string starting at i, raw strings in backticks have no escapes
+func scanString(runes []rune, i int) (int, error) {
+	quote := runes[i]
+	for end := i + 1; end < len(runes); end++ {
+		switch {
+		case runes[end] == '\\' && quote != '`':
+			end++
+		case runes[end] == '\n' && quote != '`':
+			return 0, fmt.Errorf("unterminated string at position %d", i)
+		case runes[end] == quote:
+			return end + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("unterminated string at position %d", i)
+}
+
+func next(runes []rune, i int) rune {
+	if i+1 < len(runes) {
+		return runes[i+1]
+	}
+	return 0
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isIdentifierStart(r rune) bool {
+	return r == '_' || r == ':' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isIdentifierChar(r rune) bool {
+	return isIdentifierStart(r) || isDigit(r)
+}
@@ -0,0 +1,512 @@
+// Package promql checks the syntax and types of prometheus query expressions without a prometheus server.
+// It follows the query language of prometheus 2.3, expressions it accepts are accepted by prometheus.
+package promql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type ValueType string
+
+const (
+	ValueTypeScalar ValueType = "scalar"
+	ValueTypeVector ValueType = "vector"
+	ValueTypeMatrix ValueType = "matrix"
+	ValueTypeString ValueType = "string"
+)
+
+// binaryPrecedence is the precedence of the binary operators, higher binds tighter
+var binaryPrecedence = map[string]int{
+	"or":     1,
+	"and":    2,
+	"unless": 2,
+	"==":     3,
+	"!=":     3,
+	"<=":     3,
+	"<":      3,
+	">=":     3,
+	">":      3,
+	"+":      4,
+	"-":      4,
+	"*":      5,
+	"/":      5,
+	"%":      5,
+	"^":      6,
+}
+
+var comparisonOperators = map[string]bool{"==": true, "!=": true, "<=": true, "<": true, ">=": true, ">": true}
+
+var setOperators = map[string]bool{"and": true, "or": true, "unless": true}
+
+// aggregations maps the aggregation operators to the type of their parameter, empty when they have none
+var aggregations = map[string]ValueType{
+	"sum":          "",
+	"avg":          "",
+	"count":        "",
+	"min":          "",
+	"max":          "",
+	"stddev":       "",
+	"stdvar":       "",
+	"topk":         ValueTypeScalar,
+	"bottomk":      ValueTypeScalar,
+	"quantile":     ValueTypeScalar,
+	"count_values": ValueTypeString,
+}
+
+var keywords = map[string]bool{
+	"by": true, "without": true, "on": true, "ignoring": true, "group_left": true, "group_right": true,
+	"offset": true, "bool": true, "and": true, "or": true, "unless": true,
+}
+
+// Check parses expr and returns the type of its result
+func Check(expr string) (ValueType, error) {
+	if strings.TrimSpace(expr) == "" {
+		return "", fmt.Errorf("expression is empty")
+	}
+
+	tokens, err := lex(expr)
+	if err != nil {
+		return "", err
+	}
+
+	p := &parser{tokens: tokens}
+	result, err := p.expr(1)
+	if err != nil {
+		return "", err
+	}
+	if t := p.peek(); t.typ != tokenEOF {
+		return "", p.unexpected(t, "end of input")
+	}
+	return result.typ, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+type node struct {
+	typ      ValueType
+	selector bool
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.typ != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(typ tokenType, context string) (token, error) {
+	t := p.next()
+	if t.typ != typ {
+		return t, p.unexpected(t, context)
+	}
+	return t, nil
+}
+
+func (p *parser) unexpected(t token, expected string) error {
+	return fmt.Errorf("unexpected %s at position %d, expected %s", t, t.pos, expected)
+}
+
+func (p *parser) errorf(t token, format string, args ...interface{}) error {
+	return fmt.Errorf("%s at position %d", fmt.Sprintf(format, args...), t.pos)
+}
+
+// binaryOperator returns the operator of the next token and its precedence, 0 if it is none
+func (p *parser) binaryOperator() (string, int) {
+	t := p.peek()
+	if t.typ != tokenOperator && t.typ != tokenIdentifier {
+		return "", 0
+	}
+	op := t.value
+	if t.typ == tokenIdentifier {
+		op = strings.ToLower(op)
+		if !setOperators[op] {
+			return "", 0
+		}
+	}
+	return op, binaryPrecedence[op]
+}
+
+func (p *parser) expr(minPrecedence int) (node, error) {
+	lhs, err := p.unary()
+	if err != nil {
+		return lhs, err
+	}
+
+	for {
+		op, precedence := p.binaryOperator()
+		if precedence == 0 || precedence < minPrecedence {
+			return lhs, nil
+		}
+		opToken := p.next()
+
+		returnBool := false
+		if p.keyword("bool") {
+			if !comparisonOperators[op] {
+				return lhs, p.errorf(opToken, "bool modifier can only be used on comparison operators")
+			}
+			p.next()
+			returnBool = true
+		}
+
+		matching := false
+		if p.keyword("on") || p.keyword("ignoring") {
+			p.next()
+			if err := p.labels(); err != nil {
+				return lhs, err
+			}
+			matching = true
+
+			if p.keyword("group_left") || p.keyword("group_right") {
+				group := p.next()
+				if setOperators[op] {
+					return lhs, p.errorf(group, "no grouping allowed for %s operation", op)
+				}
+				if p.peek().typ == tokenLeftParen {
+					if err := p.labels(); err != nil {
+						return lhs, err
+					}
+				}
+			}
+		}
+
+		// ^ is right associative, all other operators are left associative
+		nextPrecedence := precedence + 1
+		if op == "^" {
+			nextPrecedence = precedence
+		}
+		rhs, err := p.expr(nextPrecedence)
+		if err != nil {
+			return lhs, err
+		}
+
+		if lhs, err = binary(opToken, op, lhs, rhs, returnBool, matching); err != nil {
+			return lhs, err
+		}
+	}
+}
+
+func binary(opToken token, op string, lhs, rhs node, returnBool, matching bool) (node, error) {
+	for _, operand := range []node{lhs, rhs} {
+		if operand.typ != ValueTypeScalar && operand.typ != ValueTypeVector {
+			return node{}, fmt.Errorf("binary expression must contain only scalar and instant vector types, found %s at position %d", operand.typ, opToken.pos)
+		}
+	}
+
+	bothVectors := lhs.typ == ValueTypeVector && rhs.typ == ValueTypeVector
+	switch {
+	case setOperators[op] && !bothVectors:
+		return node{}, fmt.Errorf("set operator %s not allowed in binary scalar expression at position %d", op, opToken.pos)
+	case matching && !bothVectors:
+		return node{}, fmt.Errorf("vector matching only allowed between instant vectors at position %d", opToken.pos)
+	case comparisonOperators[op] && !returnBool && lhs.typ == ValueTypeScalar && rhs.typ == ValueTypeScalar:
+		return node{}, fmt.Errorf("comparisons between scalars must use bool modifier at position %d", opToken.pos)
+	}
+
+	if lhs.typ == ValueTypeVector || rhs.typ == ValueTypeVector {
+		return node{typ: ValueTypeVector}, nil
+	}
+	return node{typ: ValueTypeScalar}, nil
+}
+
+func (p *parser) unary() (node, error) {
+	if t := p.peek(); t.typ == tokenOperator && (t.value == "-" || t.value == "+") {
+		p.next()
+		operand, err := p.unary()
+		if err != nil {
+			return operand, err
+		}
+		if operand.typ != ValueTypeScalar && operand.typ != ValueTypeVector {
+			return operand, p.errorf(t, "unary expression only allowed on expressions of type scalar or instant vector, got %s", operand.typ)
+		}
+		return node{typ: operand.typ}, nil
+	}
+	return p.postfix()
+}
+
+func (p *parser) postfix() (node, error) {
+	result, err := p.primary()
+	if err != nil {
+		return result, err
+	}
+
+	if t := p.peek(); t.typ == tokenLeftBracket {
+		p.next()
+		if !result.selector || result.typ != ValueTypeVector {
+			return result, p.errorf(t, "range specification must be preceded by a metric selector")
+		}
+		if _, err := p.expect(tokenDuration, "duration"); err != nil {
+			return result, err
+		}
+		if _, err := p.expect(tokenRightBracket, "\"]\""); err != nil {
+			return result, err
+		}
+		result.typ = ValueTypeMatrix
+	}
+
+	if p.keyword("offset") {
+		t := p.next()
+		if !result.selector {
+			return result, p.errorf(t, "offset modifier must be preceded by an instant or range selector")
+		}
+		if _, err := p.expect(tokenDuration, "duration"); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+func (p *parser) primary() (node, error) {
+	t := p.peek()
+	switch t.typ {
+	case tokenNumber:
+		p.next()
+		if _, err := strconv.ParseFloat(t.value, 64); err != nil {
+			if _, err := strconv.ParseInt(t.value, 0, 64); err != nil {
+				return node{}, p.errorf(t, "invalid number %q", t.value)
+			}
+		}
+		return node{typ: ValueTypeScalar}, nil
+	case tokenString:
+		p.next()
+		if _, err := unquote(t.value); err != nil {
+			return node{}, p.errorf(t, "invalid string %s", t.value)
+		}
+		return node{typ: ValueTypeString}, nil
+	case tokenLeftParen:
+		p.next()
+		inner, err := p.expr(1)
+		if err != nil {
+			return inner, err
+		}
+		if _, err := p.expect(tokenRightParen, "\")\""); err != nil {
+			return inner, err
+		}
+		return node{typ: inner.typ}, nil
+	case tokenLeftBrace:
+		return p.selector("")
+	case tokenIdentifier:
+		name := t.value
+		lower := strings.ToLower(name)
+		if lower == "inf" || lower == "nan" {
+			p.next()
+			return node{typ: ValueTypeScalar}, nil
+		}
+		if _, ok := aggregations[lower]; ok {
+			if after := p.tokens[p.pos+1]; after.typ == tokenLeftParen || after.typ == tokenIdentifier && (strings.ToLower(after.value) == "by" || strings.ToLower(after.value) == "without") {
+				return p.aggregation()
+			}
+		}
+		if keywords[lower] {
+			return node{}, p.unexpected(t, "expression")
+		}
+		if p.tokens[p.pos+1].typ == tokenLeftParen {
+			return p.call()
+		}
+		p.next()
+		return p.selector(name)
+	}
+	return node{}, p.unexpected(t, "expression")
+}
+
+// selector parses the label matchers of a vector selector, the metric name has already been consumed
+func (p *parser) selector(name string) (node, error) {
+	nonEmpty := name != ""
+	if p.peek().typ == tokenLeftBrace {
+		open := p.next()
+		for p.peek().typ != tokenRightBrace {
+			label, err := p.expect(tokenIdentifier, "label name")
+			if err != nil {
+				return node{}, err
+			}
+			if strings.Contains(label.value, ":") {
+				return node{}, p.errorf(label, "invalid label name %q", label.value)
+			}
+			// != is lexed as a binary operator
+			op := p.next()
+			if op.typ != tokenMatchOp && !(op.typ == tokenOperator && op.value == "!=") {
+				return node{}, p.unexpected(op, "label matching operator")
+			}
+			valueToken, err := p.expect(tokenString, "label value")
+			if err != nil {
+				return node{}, err
+			}
+			value, err := unquote(valueToken.value)
+			if err != nil {
+				return node{}, p.errorf(valueToken, "invalid string %s", valueToken.value)
+			}
+
+			regex := op.value == "=~" || op.value == "!~"
+			if regex {
+				re, err := regexp.Compile("^(?:" + value + ")$")
+				if err != nil {
+					return node{}, p.errorf(valueToken, "invalid regular expression %q: %v", value, err)
+				}
+				value = ""
+				if !re.MatchString("") {
+					value = "non-empty"
+				}
+			}
+			if (op.value == "=" || op.value == "=~") && value != "" {
+				nonEmpty = true
+			}
+
+			if p.peek().typ == tokenComma {
+				p.next()
+			} else if p.peek().typ != tokenRightBrace {
+				return node{}, p.unexpected(p.peek(), "\",\" or \"}\"")
+			}
+		}
+		p.next()
+		if !nonEmpty {
+			return node{}, p.errorf(open, "vector selector must contain at least one non-empty matcher")
+		}
+	}
+	return node{typ: ValueTypeVector, selector: true}, nil
+}
+
+func (p *parser) aggregation() (node, error) {
+	op := p.next()
+	param := aggregations[strings.ToLower(op.value)]
+
+	grouped := false
+	if p.keyword("by") || p.keyword("without") {
+		p.next()
+		if err := p.labels(); err != nil {
+			return node{}, err
+		}
+		grouped = true
+	}
+
+	if _, err := p.expect(tokenLeftParen, "\"(\""); err != nil {
+		return node{}, err
+	}
+	if param != "" {
+		t := p.peek()
+		arg, err := p.expr(1)
+		if err != nil {
+			return node{}, err
+		}
+		if arg.typ != param {
+			return node{}, p.errorf(t, "expected type %s in aggregation parameter of %s, got %s", param, op.value, arg.typ)
+		}
+		if _, err := p.expect(tokenComma, "\",\""); err != nil {
+			return node{}, err
+		}
+	}
+	t := p.peek()
+	arg, err := p.expr(1)
+	if err != nil {
+		return node{}, err
+	}
+	if arg.typ != ValueTypeVector {
+		return node{}, p.errorf(t, "expected type vector in aggregation expression of %s, got %s", op.value, arg.typ)
+	}
+	if _, err := p.expect(tokenRightParen, "\")\""); err != nil {
+		return node{}, err
+	}
+
+	if !grouped && (p.keyword("by") || p.keyword("without")) {
+		p.next()
+		if err := p.labels(); err != nil {
+			return node{}, err
+		}
+	}
+	return node{typ: ValueTypeVector}, nil
+}
+
+func (p *parser) call() (node, error) {
+	name := p.next()
+	f, ok := functions[name.value]
+	if !ok {
+		return node{}, p.errorf(name, "unknown function %q", name.value)
+	}
+	p.next()
+
+	var args []token
+	var types []ValueType
+	for p.peek().typ != tokenRightParen {
+		t := p.peek()
+		arg, err := p.expr(1)
+		if err != nil {
+			return node{}, err
+		}
+		args = append(args, t)
+		types = append(types, arg.typ)
+		if p.peek().typ == tokenComma {
+			p.next()
+		} else if p.peek().typ != tokenRightParen {
+			return node{}, p.unexpected(p.peek(), "\",\" or \")\"")
+		}
+	}
+	p.next()
+
+	if err := f.check(name.value, types); err != nil {
+		return node{}, p.errorf(name, "%v", err)
+	}
+	return node{typ: f.returns}, nil
+}
+
+// labels parses a parenthesized list of label names
+func (p *parser) labels() error {
+	if _, err := p.expect(tokenLeftParen, "\"(\""); err != nil {
+		return err
+	}
+	for p.peek().typ != tokenRightParen {
+		label, err := p.expect(tokenIdentifier, "label name")
+		if err != nil {
+			return err
+		}
+		if strings.Contains(label.value, ":") {
+			return p.errorf(label, "invalid label name %q", label.value)
+		}
+		if p.peek().typ == tokenComma {
+			p.next()
+		} else if p.peek().typ != tokenRightParen {
+			return p.unexpected(p.peek(), "\",\" or \")\"")
+		}
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) keyword(name string) bool {
+	t := p.peek()
+	return t.typ == tokenIdentifier && strings.ToLower(t.value) == name
+}
+
+// unquote returns the value of a string literal, single quoted strings have the escapes of double quoted
+// ones
+func unquote(s string) (string, error) {
+	if strings.HasPrefix(s, "'") {
+		inner := []rune(s[1 : len(s)-1])
+		quoted := []rune{'"'}
+		for i := 0; i < len(inner); i++ {
+			switch {
+			case inner[i] == '\\' && i+1 < len(inner) && inner[i+1] == '\'':
+				quoted = append(quoted, '\'')
+				i++
+			case inner[i] == '\\' && i+1 < len(inner):
+				quoted = append(quoted, inner[i], inner[i+1])
+				i++
+			case inner[i] == '"':
+				quoted = append(quoted, '\\', '"')
+			default:
+				quoted = append(quoted, inner[i])
+			}
+		}
+		s = string(append(quoted, '"'))
+	}
+	return strconv.Unquote(s)
+}
@@ -0,0 +1,86 @@
+package promql
+
+import "testing"
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected ValueType
+	}{
+		{`1`, ValueTypeScalar},
+		{`-1.5e3`, ValueTypeScalar},
+		{`"text"`, ValueTypeString},
+		{`up`, ValueTypeVector},
+		{`up{job="etcd",instance!~"10\\..*"}`, ValueTypeVector},
+		{`{__name__=~"node_.*"}`, ValueTypeVector},
+		{`node_cpu_seconds_total[5m]`, ValueTypeMatrix},
+		{`node_cpu_seconds_total[5m] offset 1h`, ValueTypeMatrix},
+		{`rate(node_cpu_seconds_total{mode!="idle"}[5m])`, ValueTypeVector},
+		{`sum by (node) (rate(node_cpu_seconds_total[5m]))`, ValueTypeVector},
+		{`sum(rate(node_cpu_seconds_total[5m])) without (cpu)`, ValueTypeVector},
+		{`topk(3, up)`, ValueTypeVector},
+		{`count_values("version", build_info)`, ValueTypeVector},
+		{`histogram_quantile(0.9, rate(http_request_duration_seconds_bucket[5m]))`, ValueTypeVector},
+		{`(1 - node_memory_MemAvailable_bytes / node_memory_MemTotal_bytes) * 100 > 80`, ValueTypeVector},
+		{`up == bool 1`, ValueTypeVector},
+		{`1 < bool 2`, ValueTypeScalar},
+		{`2 ^ 3 ^ 2`, ValueTypeScalar},
+		{`up and on(job) absent(down)`, ValueTypeVector},
+		{`a * on(namespace, pod) group_left(node) kube_pod_info`, ValueTypeVector},
+		{`a / ignoring(mode) group_right b`, ValueTypeVector},
+		{`scalar(sum(up))`, ValueTypeScalar},
+		{`vector(1)`, ValueTypeVector},
+		{`time()`, ValueTypeScalar},
+		{`round(up)`, ValueTypeVector},
+		{`round(up, 5)`, ValueTypeVector},
+		{`label_replace(up, "host", "$1", "instance", "(.*):.*")`, ValueTypeVector},
+		{`-up`, ValueTypeVector},
+		{"up # comment\n  > 0", ValueTypeVector},
+	}
+
+	for _, test := range tests {
+		result, err := Check(test.expr)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.expr, err)
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("%s: expected a %s, got a %s", test.expr, test.expected, result)
+		}
+	}
+}
+
+func TestCheckRejects(t *testing.T) {
+	for _, expr := range []string{
+		``,
+		`   `,
+		`up{`,
+		`up{job="etcd"`,
+		`up{job=etcd}`,
+		`up{job~"etcd"}`,
+		`{}`,
+		`{job=""}`,
+		`up[5x]`,
+		`up[5m][5m]`,
+		`rate(up)`,
+		`rate(up[5m], 1)`,
+		`sum(up[5m])`,
+		`topk(up)`,
+		`unknown_function(up)`,
+		`(up`,
+		`up)`,
+		`up +`,
+		`1 == 1`,
+		`up[5m] + 1`,
+		`"a" + "b"`,
+		`sum by (node) up`,
+		`up and 1`,
+		`up * on(job) group_left(node)`,
+		`up offset`,
+		`'unterminated`,
+	} {
+		if result, err := Check(expr); err == nil {
+			t.Errorf("%q: expected an error, got a %s", expr, result)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package resourcequota
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ContainerLimitAnnotation holds the container default resource limit override of a namespace as
+	// written by the namespace schema
+	ContainerLimitAnnotation = "field.cattle.io/containerDefaultResourceLimit"
+	// LimitRangeName is the name of the limit range that applies the container defaults in a namespace
+	LimitRangeName = "default-limitrange"
+)
+
+// containerResources maps the fields of v3.ContainerResourceLimit to the resource they default and
+// whether it is a request or a limit
+var containerResources = []struct {
+	field   string
+	name    v1.ResourceName
+	request bool
+	quota   v1.ResourceName
+	value   func(*v3.ContainerResourceLimit) string
+}{
+	{"requestsCpu", v1.ResourceCPU, true, v1.ResourceRequestsCPU, func(l *v3.ContainerResourceLimit) string { return l.RequestsCPU }},
+	{"requestsMemory", v1.ResourceMemory, true, v1.ResourceRequestsMemory, func(l *v3.ContainerResourceLimit) string { return l.RequestsMemory }},
+	{"limitsCpu", v1.ResourceCPU, false, v1.ResourceLimitsCPU, func(l *v3.ContainerResourceLimit) string { return l.LimitsCPU }},
+	{"limitsMemory", v1.ResourceMemory, false, v1.ResourceLimitsMemory, func(l *v3.ContainerResourceLimit) string { return l.LimitsMemory }},
+}
+
+// ContainerLimitFromNamespace returns the container default resource limit override of a namespace, nil
+// if it has none
+func ContainerLimitFromNamespace(namespace *v1.Namespace) (*v3.ContainerResourceLimit, error) {
+	value := namespace.Annotations[ContainerLimitAnnotation]
+	if value == "" {
+		return nil, nil
+	}
+	limit := &v3.ContainerResourceLimit{}
+	if err := json.Unmarshal([]byte(value), limit); err != nil {
+		return nil, fmt.Errorf("invalid container default resource limit of namespace %s: %v", namespace.Name, err)
+	}
+	return limit, nil
+}
+
+// EffectiveContainerLimit returns the container defaults of a namespace of the project, which are its
+// override or otherwise the defaults of the project
+func EffectiveContainerLimit(project *v3.ProjectSpec, override *v3.ContainerResourceLimit) *v3.ContainerResourceLimit {
+	if override != nil {
+		return override
+	}
+	return project.ContainerDefaultResourceLimit
+}
+
+// ValidateContainerLimit checks that the quantities of limit parse and that no request is larger than the
+// limit of the same resource
+func ValidateContainerLimit(limit *v3.ContainerResourceLimit) error {
+	if limit == nil {
+		return nil
+	}
+	return limit.Validate().Err()
+}
+
+// LimitRangeSpec returns the spec of the limit range that applies the container defaults, nil when there
+// are no defaults
+func LimitRangeSpec(limit *v3.ContainerResourceLimit) (*v1.LimitRangeSpec, error) {
+	if limit == nil {
+		return nil, nil
+	}
+	item, err := containerDefaults(limit)
+	if err != nil || (len(item.Default) == 0 && len(item.DefaultRequest) == 0) {
+		return nil, err
+	}
+	return &v1.LimitRangeSpec{Limits: []v1.LimitRangeItem{item}}, nil
+}
+
+// LimitRange returns the limit range named LimitRangeName that applies the container defaults in
+// namespace, nil when there are no defaults
+func LimitRange(namespace string, limit *v3.ContainerResourceLimit) (*v1.LimitRange, error) {
+	spec, err := LimitRangeSpec(limit)
+	if err != nil || spec == nil {
+		return nil, err
+	}
+	return &v1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LimitRangeName,
+			Namespace: namespace,
+		},
+		Spec: *spec,
+	}, nil
+}
+
+// validateContainerDefaults checks that containers which set no resources are not rejected by the quota
+// of their namespace, which happens when the quota limits a resource the defaults do not set
+func validateContainerDefaults(quota v1.ResourceList, limit *v3.ContainerResourceLimit) error {
+	var missing []string
+	for _, r := range containerResources {
+		if _, ok := quota[r.quota]; !ok {
+			continue
+		}
+		if limit == nil || r.value(limit) == "" {
+			missing = append(missing, r.field)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("containerDefaultResourceLimit must set %s, they are limited by the resource quota", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func containerDefaults(limit *v3.ContainerResourceLimit) (v1.LimitRangeItem, error) {
+	if err := limit.Validate().Err(); err != nil {
+		return v1.LimitRangeItem{}, err
+	}
+
+	item := v1.LimitRangeItem{
+		Type:           v1.LimitTypeContainer,
+		Default:        v1.ResourceList{},
+		DefaultRequest: v1.ResourceList{},
+	}
+	for _, r := range containerResources {
+		value := r.value(limit)
+		if value == "" {
+			continue
+		}
+		quantity := resource.MustParse(value)
+		if r.request {
+			item.DefaultRequest[r.name] = quantity
+		} else {
+			item.Default[r.name] = quantity
+		}
+	}
+	return item, nil
+}
@@ -0,0 +1,191 @@
+package resourcequota
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLimitRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		limit    *v3.ContainerResourceLimit
+		expected *v1.LimitRange
+		err      string
+	}{
+		{
+			name: "no defaults",
+		},
+		{
+			name:  "empty defaults",
+			limit: &v3.ContainerResourceLimit{},
+		},
+		{
+			name: "requests and limits",
+			limit: &v3.ContainerResourceLimit{
+				RequestsCPU:    "100m",
+				RequestsMemory: "128Mi",
+				LimitsCPU:      "500m",
+				LimitsMemory:   "512Mi",
+			},
+			expected: limitRange(v1.LimitRangeItem{
+				Type: v1.LimitTypeContainer,
+				Default: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("500m"),
+					v1.ResourceMemory: resource.MustParse("512Mi"),
+				},
+				DefaultRequest: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("100m"),
+					v1.ResourceMemory: resource.MustParse("128Mi"),
+				},
+			}),
+		},
+		{
+			name:  "only limits",
+			limit: &v3.ContainerResourceLimit{LimitsMemory: "1Gi"},
+			expected: limitRange(v1.LimitRangeItem{
+				Type:           v1.LimitTypeContainer,
+				Default:        v1.ResourceList{v1.ResourceMemory: resource.MustParse("1Gi")},
+				DefaultRequest: v1.ResourceList{},
+			}),
+		},
+		{
+			name:  "only requests",
+			limit: &v3.ContainerResourceLimit{RequestsCPU: "250m"},
+			expected: limitRange(v1.LimitRangeItem{
+				Type:           v1.LimitTypeContainer,
+				Default:        v1.ResourceList{},
+				DefaultRequest: v1.ResourceList{v1.ResourceCPU: resource.MustParse("250m")},
+			}),
+		},
+		{
+			name:  "request equal to the limit",
+			limit: &v3.ContainerResourceLimit{RequestsMemory: "1024Mi", LimitsMemory: "1Gi"},
+			expected: limitRange(v1.LimitRangeItem{
+				Type:           v1.LimitTypeContainer,
+				Default:        v1.ResourceList{v1.ResourceMemory: resource.MustParse("1Gi")},
+				DefaultRequest: v1.ResourceList{v1.ResourceMemory: resource.MustParse("1024Mi")},
+			}),
+		},
+		{
+			name:  "request larger than the limit",
+			limit: &v3.ContainerResourceLimit{RequestsCPU: "2", LimitsCPU: "1500m"},
+			err:   "requestsCpu",
+		},
+		{
+			name:  "invalid quantity",
+			limit: &v3.ContainerResourceLimit{LimitsMemory: "1 GB"},
+			err:   "limitsMemory",
+		},
+	}
+
+	for _, test := range tests {
+		limitRange, err := LimitRange("p-x7k2m-apps", test.limit)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%s: expected an error of %s, got %v", test.name, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(limitRange, test.expected) {
+			t.Errorf("%s: expected %+v, got %+v", test.name, test.expected, limitRange)
+		}
+	}
+}
+
+func limitRange(item v1.LimitRangeItem) *v1.LimitRange {
+	return &v1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LimitRangeName,
+			Namespace: "p-x7k2m-apps",
+		},
+		Spec: v1.LimitRangeSpec{Limits: []v1.LimitRangeItem{item}},
+	}
+}
+
+func TestContainerDefaultsOfNamespace(t *testing.T) {
+	project := &v3.ProjectSpec{
+		ContainerDefaultResourceLimit: &v3.ContainerResourceLimit{LimitsMemory: "256Mi"},
+	}
+
+	namespace := &v1.Namespace{}
+	override, err := ContainerLimitFromNamespace(namespace)
+	if err != nil || override != nil {
+		t.Fatalf("expected no override, got %+v, %v", override, err)
+	}
+	if limit := EffectiveContainerLimit(project, override); limit != project.ContainerDefaultResourceLimit {
+		t.Errorf("expected the defaults of the project, got %+v", limit)
+	}
+
+	namespace.Annotations = map[string]string{ContainerLimitAnnotation: `{"limitsMemory":"1Gi"}`}
+	override, err = ContainerLimitFromNamespace(namespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if limit := EffectiveContainerLimit(project, override); limit.LimitsMemory != "1Gi" {
+		t.Errorf("expected the override of the namespace, got %+v", limit)
+	}
+
+	namespace.Annotations[ContainerLimitAnnotation] = `{"limitsMemory":`
+	if _, err := ContainerLimitFromNamespace(namespace); err == nil {
+		t.Error("expected an invalid annotation to be rejected")
+	}
+}
+
+func TestValidateProjectContainerDefaults(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit *v3.ContainerResourceLimit
+		err   string
+	}{
+		{
+			name:  "defaults of every limited resource",
+			limit: &v3.ContainerResourceLimit{RequestsCPU: "100m", LimitsMemory: "256Mi"},
+		},
+		{
+			name:  "defaults of resources the quota does not limit",
+			limit: &v3.ContainerResourceLimit{RequestsCPU: "100m", RequestsMemory: "64Mi", LimitsCPU: "1", LimitsMemory: "256Mi"},
+		},
+		{
+			name:  "no default of a limited resource",
+			limit: &v3.ContainerResourceLimit{RequestsCPU: "100m"},
+			err:   "containerDefaultResourceLimit must set limitsMemory, they are limited by the resource quota",
+		},
+		{
+			name: "no defaults",
+			err:  "containerDefaultResourceLimit must set requestsCpu, limitsMemory, they are limited by the resource quota",
+		},
+		{
+			name:  "request larger than the limit",
+			limit: &v3.ContainerResourceLimit{RequestsCPU: "100m", RequestsMemory: "512Mi", LimitsMemory: "256Mi"},
+			err:   "containerDefaultResourceLimit: ",
+		},
+	}
+
+	for _, test := range tests {
+		project := &v3.ProjectSpec{
+			ResourceQuota:                 &v3.ProjectResourceQuota{Limit: v3.ResourceQuotaLimit{RequestsCPU: "8", LimitsMemory: "16Gi"}},
+			NamespaceDefaultResourceQuota: quota(v3.ResourceQuotaLimit{RequestsCPU: "1", LimitsMemory: "2Gi"}),
+			ContainerDefaultResourceLimit: test.limit,
+		}
+		err := ValidateProject(project)
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", test.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.HasPrefix(err.Error(), test.err) {
+			t.Errorf("%s: expected an error starting with %q, got %v", test.name, test.err, err)
+		}
+	}
+}
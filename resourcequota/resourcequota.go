@@ -0,0 +1,239 @@
+package resourcequota
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Annotation holds the quota override of a namespace as written by the namespace schema
+const Annotation = "field.cattle.io/resourceQuota"
+
+// resources maps the fields of v3.ResourceQuotaLimit to the kubernetes resource they limit, in the order
+// they are reported
+var resources = []struct {
+	field string
+	name  v1.ResourceName
+	value func(*v3.ResourceQuotaLimit) *string
+}{
+	{"pods", v1.ResourcePods, func(l *v3.ResourceQuotaLimit) *string { return &l.Pods }},
+	{"services", v1.ResourceServices, func(l *v3.ResourceQuotaLimit) *string { return &l.Services }},
+	{"replicationControllers", v1.ResourceReplicationControllers, func(l *v3.ResourceQuotaLimit) *string { return &l.ReplicationControllers }},
+	{"secrets", v1.ResourceSecrets, func(l *v3.ResourceQuotaLimit) *string { return &l.Secrets }},
+	{"configMaps", v1.ResourceConfigMaps, func(l *v3.ResourceQuotaLimit) *string { return &l.ConfigMaps }},
+	{"persistentVolumeClaims", v1.ResourcePersistentVolumeClaims, func(l *v3.ResourceQuotaLimit) *string { return &l.PersistentVolumeClaims }},
+	{"servicesNodePorts", v1.ResourceServicesNodePorts, func(l *v3.ResourceQuotaLimit) *string { return &l.ServicesNodePorts }},
+	{"servicesLoadBalancers", v1.ResourceServicesLoadBalancers, func(l *v3.ResourceQuotaLimit) *string { return &l.ServicesLoadBalancers }},
+	{"requestsCpu", v1.ResourceRequestsCPU, func(l *v3.ResourceQuotaLimit) *string { return &l.RequestsCPU }},
+	{"requestsMemory", v1.ResourceRequestsMemory, func(l *v3.ResourceQuotaLimit) *string { return &l.RequestsMemory }},
+	{"requestsStorage", v1.ResourceRequestsStorage, func(l *v3.ResourceQuotaLimit) *string { return &l.RequestsStorage }},
+	{"limitsCpu", v1.ResourceLimitsCPU, func(l *v3.ResourceQuotaLimit) *string { return &l.LimitsCPU }},
+	{"limitsMemory", v1.ResourceLimitsMemory, func(l *v3.ResourceQuotaLimit) *string { return &l.LimitsMemory }},
+}
+
+// ResourceList parses the quantities of limit, resources that are not limited are left out
+func ResourceList(limit v3.ResourceQuotaLimit) (v1.ResourceList, error) {
+	result := v1.ResourceList{}
+	for _, r := range resources {
+		value := *r.value(&limit)
+		if value == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %v", r.field, value, err)
+		}
+		if quantity.Sign() < 0 {
+			return nil, fmt.Errorf("invalid %s %q: must not be negative", r.field, value)
+		}
+		result[r.name] = quantity
+	}
+	return result, nil
+}
+
+// Limit is the inverse of ResourceList, resources that have no matching field are dropped
+func Limit(list v1.ResourceList) v3.ResourceQuotaLimit {
+	result := v3.ResourceQuotaLimit{}
+	for _, r := range resources {
+		if quantity, ok := list[r.name]; ok {
+			*r.value(&result) = quantity.String()
+		}
+	}
+	return result
+}
+
+// Spec returns the spec of the kubernetes resource quota that enforces quota in a namespace, nil when
+// the namespace is not limited
+func Spec(quota *v3.NamespaceResourceQuota) (*v1.ResourceQuotaSpec, error) {
+	if quota == nil {
+		return nil, nil
+	}
+	list, err := ResourceList(quota.Limit)
+	if err != nil || len(list) == 0 {
+		return nil, err
+	}
+	return &v1.ResourceQuotaSpec{Hard: list}, nil
+}
+
+// FromNamespace returns the quota override of a namespace, nil if it has none
+func FromNamespace(namespace *v1.Namespace) (*v3.NamespaceResourceQuota, error) {
+	value := namespace.Annotations[Annotation]
+	if value == "" {
+		return nil, nil
+	}
+	quota := &v3.NamespaceResourceQuota{}
+	if err := json.Unmarshal([]byte(value), quota); err != nil {
+		return nil, fmt.Errorf("invalid resource quota of namespace %s: %v", namespace.Name, err)
+	}
+	return quota, nil
+}
+
+// Effective returns the quota a namespace of the project gets, which is its override or otherwise the
+// namespace default of the project
+func Effective(project *v3.ProjectSpec, override *v3.NamespaceResourceQuota) *v3.NamespaceResourceQuota {
+	if override != nil {
+		return override
+	}
+	return project.NamespaceDefaultResourceQuota
+}
+
+// ValidateProject checks that every resource limited by the project is limited by the namespace default
+// as well, otherwise a namespace could consume it without bound, that the default fits in the project and
+// that the container defaults satisfy the namespace default
+func ValidateProject(project *v3.ProjectSpec) error {
+	if err := ValidateContainerLimit(project.ContainerDefaultResourceLimit); err != nil {
+		return fmt.Errorf("containerDefaultResourceLimit: %v", err)
+	}
+	if project.ResourceQuota == nil {
+		if project.NamespaceDefaultResourceQuota != nil {
+			return fmt.Errorf("namespaceDefaultResourceQuota requires resourceQuota to be set")
+		}
+		return nil
+	}
+	if project.NamespaceDefaultResourceQuota == nil {
+		return fmt.Errorf("namespaceDefaultResourceQuota is required when resourceQuota is set")
+	}
+
+	projectLimit, err := ResourceList(project.ResourceQuota.Limit)
+	if err != nil {
+		return fmt.Errorf("resourceQuota: %v", err)
+	}
+	defaultLimit, err := ResourceList(project.NamespaceDefaultResourceQuota.Limit)
+	if err != nil {
+		return fmt.Errorf("namespaceDefaultResourceQuota: %v", err)
+	}
+
+	var missing []string
+	for _, r := range resources {
+		if _, ok := projectLimit[r.name]; !ok {
+			continue
+		}
+		if _, ok := defaultLimit[r.name]; !ok {
+			missing = append(missing, r.field)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("namespaceDefaultResourceQuota must limit %s", strings.Join(missing, ", "))
+	}
+
+	if exceeded := exceeds(defaultLimit, projectLimit); len(exceeded) > 0 {
+		return fmt.Errorf("namespaceDefaultResourceQuota exceeds the project limit for %s", strings.Join(exceeded, ", "))
+	}
+	return validateContainerDefaults(defaultLimit, project.ContainerDefaultResourceLimit)
+}
+
+// Used sums the quotas of namespaces, a nil entry is a namespace without quota and is not counted
+func Used(namespaces []*v3.NamespaceResourceQuota) (v1.ResourceList, error) {
+	result := v1.ResourceList{}
+	for _, namespace := range namespaces {
+		if namespace == nil {
+			continue
+		}
+		list, err := ResourceList(namespace.Limit)
+		if err != nil {
+			return nil, err
+		}
+		for name, quantity := range list {
+			total := result[name]
+			total.Add(quantity)
+			result[name] = total
+		}
+	}
+	return result, nil
+}
+
+// Fits checks that quota can be given to a namespace of the project in addition to the quotas of the other
+// namespaces of the project. Both quota and the entries of others are effective quotas, see Effective.
+func Fits(project *v3.ProjectSpec, others []*v3.NamespaceResourceQuota, quota *v3.NamespaceResourceQuota) error {
+	if project.ResourceQuota == nil {
+		return nil
+	}
+	projectLimit, err := ResourceList(project.ResourceQuota.Limit)
+	if err != nil {
+		return fmt.Errorf("resourceQuota: %v", err)
+	}
+
+	var limit v1.ResourceList
+	if quota != nil {
+		if limit, err = ResourceList(quota.Limit); err != nil {
+			return err
+		}
+	}
+	var missing []string
+	for _, r := range resources {
+		if _, ok := projectLimit[r.name]; !ok {
+			continue
+		}
+		if _, ok := limit[r.name]; !ok {
+			missing = append(missing, r.field)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("resource quota must limit %s, they are limited by the project", strings.Join(missing, ", "))
+	}
+
+	used, err := Used(append(append([]*v3.NamespaceResourceQuota{}, others...), quota))
+	if err != nil {
+		return err
+	}
+	if exceeded := exceeds(used, projectLimit); len(exceeded) > 0 {
+		return fmt.Errorf("resource quota exceeds the limit of the project for %s", strings.Join(exceeded, ", "))
+	}
+	return nil
+}
+
+// Usage returns the project limit and the sum of the quotas of its namespaces, nil if the project is not
+// limited. The entries of namespaces are effective quotas, see Effective.
+func Usage(project *v3.ProjectSpec, namespaces []*v3.NamespaceResourceQuota) (*v3.ProjectResourceQuotaUsage, error) {
+	if project.ResourceQuota == nil {
+		return nil, nil
+	}
+	used, err := Used(namespaces)
+	if err != nil {
+		return nil, err
+	}
+	return &v3.ProjectResourceQuotaUsage{
+		Limit:     project.ResourceQuota.Limit,
+		UsedLimit: Limit(used),
+	}, nil
+}
+
+// exceeds returns the fields of the resources in used that are larger than in limit, resources that limit
+// does not have are unbounded
+func exceeds(used, limit v1.ResourceList) []string {
+	var result []string
+	for _, r := range resources {
+		max, ok := limit[r.name]
+		if !ok {
+			continue
+		}
+		if quantity, ok := used[r.name]; ok && quantity.Cmp(max) > 0 {
+			result = append(result, r.field)
+		}
+	}
+	return result
+}
@@ -0,0 +1,217 @@
+package resourcequota
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func quota(limit v3.ResourceQuotaLimit) *v3.NamespaceResourceQuota {
+	return &v3.NamespaceResourceQuota{Limit: limit}
+}
+
+func TestValidateProject(t *testing.T) {
+	tests := []struct {
+		name    string
+		project v3.ProjectSpec
+		err     string
+	}{
+		{
+			name: "no quota",
+		},
+		{
+			name: "namespace default within the project",
+			project: v3.ProjectSpec{
+				ResourceQuota:                 &v3.ProjectResourceQuota{Limit: v3.ResourceQuotaLimit{Pods: "100", ConfigMaps: "500"}},
+				NamespaceDefaultResourceQuota: quota(v3.ResourceQuotaLimit{Pods: "10", ConfigMaps: "50", Secrets: "20"}),
+			},
+		},
+		{
+			name: "namespace default without a project quota",
+			project: v3.ProjectSpec{
+				NamespaceDefaultResourceQuota: quota(v3.ResourceQuotaLimit{Pods: "10"}),
+			},
+			err: "namespaceDefaultResourceQuota requires resourceQuota to be set",
+		},
+		{
+			name: "project quota without a namespace default",
+			project: v3.ProjectSpec{
+				ResourceQuota: &v3.ProjectResourceQuota{Limit: v3.ResourceQuotaLimit{Pods: "100"}},
+			},
+			err: "namespaceDefaultResourceQuota is required when resourceQuota is set",
+		},
+		{
+			name: "namespace default does not limit a project resource",
+			project: v3.ProjectSpec{
+				ResourceQuota:                 &v3.ProjectResourceQuota{Limit: v3.ResourceQuotaLimit{Pods: "100", ConfigMaps: "50", RequestsCPU: "8"}},
+				NamespaceDefaultResourceQuota: quota(v3.ResourceQuotaLimit{Pods: "10"}),
+			},
+			err: "namespaceDefaultResourceQuota must limit configMaps, requestsCpu",
+		},
+		{
+			name: "namespace default exceeds the project",
+			project: v3.ProjectSpec{
+				ResourceQuota:                 &v3.ProjectResourceQuota{Limit: v3.ResourceQuotaLimit{Pods: "100", RequestsMemory: "4Gi"}},
+				NamespaceDefaultResourceQuota: quota(v3.ResourceQuotaLimit{Pods: "101", RequestsMemory: "4096Mi"}),
+			},
+			err: "namespaceDefaultResourceQuota exceeds the project limit for pods",
+		},
+		{
+			name: "invalid quantity",
+			project: v3.ProjectSpec{
+				ResourceQuota:                 &v3.ProjectResourceQuota{Limit: v3.ResourceQuotaLimit{LimitsCPU: "two"}},
+				NamespaceDefaultResourceQuota: quota(v3.ResourceQuotaLimit{LimitsCPU: "1"}),
+			},
+			err: "resourceQuota: invalid limitsCpu",
+		},
+		{
+			name: "negative quantity",
+			project: v3.ProjectSpec{
+				ResourceQuota:                 &v3.ProjectResourceQuota{Limit: v3.ResourceQuotaLimit{Pods: "10"}},
+				NamespaceDefaultResourceQuota: quota(v3.ResourceQuotaLimit{Pods: "-1"}),
+			},
+			err: "namespaceDefaultResourceQuota: invalid pods",
+		},
+	}
+
+	for _, test := range tests {
+		err := ValidateProject(&test.project)
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", test.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.HasPrefix(err.Error(), test.err) {
+			t.Errorf("%s: expected an error starting with %q, got %v", test.name, test.err, err)
+		}
+	}
+}
+
+func TestUsed(t *testing.T) {
+	used, err := Used([]*v3.NamespaceResourceQuota{
+		quota(v3.ResourceQuotaLimit{Pods: "10", RequestsMemory: "512Mi"}),
+		nil,
+		quota(v3.ResourceQuotaLimit{Pods: "5", RequestsMemory: "1Gi", Secrets: "3"}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[v1.ResourceName]string{
+		v1.ResourcePods:           "15",
+		v1.ResourceRequestsMemory: "1536Mi",
+		v1.ResourceSecrets:        "3",
+	}
+	if len(used) != len(expected) {
+		t.Errorf("expected %d resources, got %v", len(expected), used)
+	}
+	for name, value := range expected {
+		quantity, ok := used[name]
+		if !ok || quantity.Cmp(resource.MustParse(value)) != 0 {
+			t.Errorf("expected %s %s, got %s", name, value, quantity.String())
+		}
+	}
+
+	if _, err := Used([]*v3.NamespaceResourceQuota{quota(v3.ResourceQuotaLimit{Pods: "ten"})}); err == nil {
+		t.Error("expected an invalid quota to be rejected")
+	}
+}
+
+func TestFits(t *testing.T) {
+	project := &v3.ProjectSpec{
+		ResourceQuota: &v3.ProjectResourceQuota{Limit: v3.ResourceQuotaLimit{Pods: "20", LimitsCPU: "4"}},
+	}
+	others := []*v3.NamespaceResourceQuota{
+		quota(v3.ResourceQuotaLimit{Pods: "10", LimitsCPU: "1500m"}),
+		quota(v3.ResourceQuotaLimit{Pods: "5", LimitsCPU: "1"}),
+	}
+
+	tests := []struct {
+		name    string
+		project *v3.ProjectSpec
+		others  []*v3.NamespaceResourceQuota
+		quota   *v3.NamespaceResourceQuota
+		err     string
+	}{
+		{
+			name:    "project without quota",
+			project: &v3.ProjectSpec{},
+			others:  others,
+			quota:   quota(v3.ResourceQuotaLimit{Pods: "1000"}),
+		},
+		{
+			name:    "first namespace",
+			project: project,
+			quota:   quota(v3.ResourceQuotaLimit{Pods: "20", LimitsCPU: "4"}),
+		},
+		{
+			name:    "fits next to the other namespaces",
+			project: project,
+			others:  others,
+			quota:   quota(v3.ResourceQuotaLimit{Pods: "5", LimitsCPU: "1500m", Secrets: "100"}),
+		},
+		{
+			name:    "exceeds the project together with the other namespaces",
+			project: project,
+			others:  others,
+			quota:   quota(v3.ResourceQuotaLimit{Pods: "6", LimitsCPU: "1"}),
+			err:     "resource quota exceeds the limit of the project for pods",
+		},
+		{
+			name:    "exceeds the project alone",
+			project: project,
+			quota:   quota(v3.ResourceQuotaLimit{Pods: "1", LimitsCPU: "4001m"}),
+			err:     "resource quota exceeds the limit of the project for limitsCpu",
+		},
+		{
+			name:    "does not limit a resource of the project",
+			project: project,
+			others:  others,
+			quota:   quota(v3.ResourceQuotaLimit{Pods: "1"}),
+			err:     "resource quota must limit limitsCpu, they are limited by the project",
+		},
+		{
+			name:    "namespace without quota",
+			project: project,
+			others:  others,
+			err:     "resource quota must limit pods, limitsCpu, they are limited by the project",
+		},
+	}
+
+	for _, test := range tests {
+		err := Fits(test.project, test.others, test.quota)
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", test.name, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != test.err {
+			t.Errorf("%s: expected error %q, got %v", test.name, test.err, err)
+		}
+	}
+}
+
+func TestUsage(t *testing.T) {
+	project := &v3.ProjectSpec{
+		ResourceQuota: &v3.ProjectResourceQuota{Limit: v3.ResourceQuotaLimit{Pods: "20"}},
+	}
+	usage, err := Usage(project, []*v3.NamespaceResourceQuota{
+		quota(v3.ResourceQuotaLimit{Pods: "10"}),
+		quota(v3.ResourceQuotaLimit{Pods: "4"}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage.Limit.Pods != "20" || usage.UsedLimit.Pods != "14" {
+		t.Errorf("expected 14 of 20 pods, got %+v", usage)
+	}
+
+	if usage, err := Usage(&v3.ProjectSpec{}, nil); usage != nil || err != nil {
+		t.Errorf("expected no usage of a project without quota, got %+v, %v", usage, err)
+	}
+}
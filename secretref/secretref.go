@@ -0,0 +1,111 @@
+package secretref
+
+import (
+	"fmt"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"k8s.io/api/core/v1"
+)
+
+// SecretLister gets secrets by namespace and name, the generated core v1 SecretLister satisfies it
+type SecretLister interface {
+	Get(namespace, name string) (*v1.Secret, error)
+}
+
+type credential struct {
+	path string
+	ref  *v3.SecretKeyRef
+	// set stores the resolved value and clears the reference
+	set func(value string)
+}
+
+// Resolve returns a copy of config with every credential that refers to a secret read from that secret.
+// The references are cleared in the copy so that it can be rendered into the plan as is. The copy holds
+// plaintext credentials and must not be stored.
+func Resolve(config *v3.RancherKubernetesEngineConfig, secrets SecretLister) (*v3.RancherKubernetesEngineConfig, error) {
+	result := config.DeepCopy()
+
+	for _, c := range credentials(result) {
+		value, err := lookup(c.ref, secrets)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve %s: %v", c.path, err)
+		}
+		c.set(value)
+	}
+
+	return result, nil
+}
+
+// References returns the references of config by field path, for example to watch the secrets they refer to
+func References(config *v3.RancherKubernetesEngineConfig) map[string]v3.SecretKeyRef {
+	result := map[string]v3.SecretKeyRef{}
+	for _, c := range credentials(config) {
+		result[c.path] = *c.ref
+	}
+	return result
+}
+
+func lookup(ref *v3.SecretKeyRef, secrets SecretLister) (string, error) {
+	secret, err := secrets.Get(ref.Namespace, ref.Name)
+	if err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("secret [%s/%s] has no key [%s]", ref.Namespace, ref.Name, ref.Key)
+	}
+	return string(value), nil
+}
+
+// credentials returns every field of config that refers to a secret
+func credentials(config *v3.RancherKubernetesEngineConfig) []credential {
+	var result []credential
+	add := func(path string, ref **v3.SecretKeyRef, value *string) {
+		if *ref != nil {
+			result = append(result, credential{path: path, ref: *ref, set: func(v string) {
+				*value = v
+				*ref = nil
+			}})
+		}
+	}
+
+	for i := range config.Nodes {
+		node := &config.Nodes[i]
+		add(fmt.Sprintf("nodes[%d].sshKeySecretRef", i), &node.SSHKeySecretRef, &node.SSHKey)
+	}
+	add("bastionHost.sshKeySecretRef", &config.BastionHost.SSHKeySecretRef, &config.BastionHost.SSHKey)
+	for i := range config.PrivateRegistries {
+		registry := &config.PrivateRegistries[i]
+		add(fmt.Sprintf("privateRegistries[%d].passwordSecretRef", i), &registry.PasswordSecretRef, &registry.Password)
+	}
+
+	cp := &config.CloudProvider
+	if azure := cp.AzureCloudProvider; azure != nil {
+		add("cloudProvider.azureCloudProvider.aadClientSecretRef", &azure.AADClientSecretRef, &azure.AADClientSecret)
+		add("cloudProvider.azureCloudProvider.aadClientCertPasswordRef", &azure.AADClientCertPasswordRef, &azure.AADClientCertPassword)
+	}
+	if vsphere := cp.VsphereCloudProvider; vsphere != nil {
+		add("cloudProvider.vsphereCloudProvider.global.passwordSecretRef", &vsphere.Global.PasswordSecretRef, &vsphere.Global.Password)
+		for server, vc := range vsphere.VirtualCenter {
+			if vc.PasswordSecretRef == nil {
+				continue
+			}
+			// map values are not addressable, the resolved copy is written back
+			server, vc := server, vc
+			result = append(result, credential{
+				path: fmt.Sprintf("cloudProvider.vsphereCloudProvider.virtualCenter[%s].passwordSecretRef", server),
+				ref:  vc.PasswordSecretRef,
+				set: func(v string) {
+					vc.Password = v
+					vc.PasswordSecretRef = nil
+					vsphere.VirtualCenter[server] = vc
+				},
+			})
+		}
+	}
+	if openstack := cp.OpenstackCloudProvider; openstack != nil {
+		add("cloudProvider.openstackCloudProvider.global.passwordSecretRef", &openstack.Global.PasswordSecretRef, &openstack.Global.Password)
+	}
+
+	return result
+}
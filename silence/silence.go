@@ -0,0 +1,200 @@
+// Package silence computes the occurrences of the silence windows of alerts and the suppression reported in
+// their status.
+package silence
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+const (
+	ReasonMuted     = "muted"
+	ReasonSilenced  = "silenced"
+	ReasonInhibited = "inhibited"
+)
+
+// Occurrence is one occurrence of a silence window
+type Occurrence struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Active returns the occurrence of window that contains now
+func Active(window v3.SilenceWindow, now time.Time) (Occurrence, bool, error) {
+	occurrence, ok, err := latest(window, now)
+	if err != nil || !ok {
+		return Occurrence{}, false, err
+	}
+	return occurrence.Occurrence, now.Before(occurrence.End), nil
+}
+
+// Next returns the first occurrence of window that ends after now, it may already have started
+func Next(window v3.SilenceWindow, now time.Time) (Occurrence, bool, error) {
+	occurrence, ok, err := latest(window, now)
+	if err != nil {
+		return Occurrence{}, false, err
+	}
+	if ok && now.Before(occurrence.End) {
+		return occurrence.Occurrence, true, nil
+	}
+
+	start, end, until, err := parse(window)
+	if err != nil {
+		return Occurrence{}, false, err
+	}
+	if !ok {
+		// now is before the first occurrence
+		return Occurrence{Start: start, End: end}, true, nil
+	}
+	if window.Recurrence == "" || window.Recurrence == "none" {
+		return Occurrence{}, false, nil
+	}
+	next := occurrenceAt(start, end, window.Recurrence, occurrence.index+1)
+	if !until.IsZero() && next.Start.After(until) {
+		return Occurrence{}, false, nil
+	}
+	return next.Occurrence, true, nil
+}
+
+// Suppression returns why the notifications of an alert are held back at now, nil when they are not.
+// inhibitedBy are the names of the firing alerts that inhibit it, as reported by alertmanager.
+func Suppression(spec v3.AlertCommonSpec, status v3.AlertStatus, inhibitedBy []string, now time.Time) (*v3.AlertSuppression, error) {
+	if status.AlertState == "muted" {
+		return &v3.AlertSuppression{
+			Reason:  ReasonMuted,
+			Message: "the alert is muted",
+		}, nil
+	}
+
+	var active []Occurrence
+	var comments []string
+	for _, window := range spec.SilenceWindows {
+		occurrence, ok, err := Active(window, now)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			active = append(active, occurrence)
+			if window.Comment != "" {
+				comments = append(comments, window.Comment)
+			}
+		}
+	}
+	if len(active) > 0 {
+		// overlapping windows silence the alert until the last of them ends
+		sort.Slice(active, func(i, j int) bool { return active[i].End.Before(active[j].End) })
+		message := "silence window is active"
+		if len(comments) > 0 {
+			message += ": " + strings.Join(comments, ", ")
+		}
+		return &v3.AlertSuppression{
+			Reason:  ReasonSilenced,
+			Message: message,
+			Until:   active[len(active)-1].End.Format(time.RFC3339),
+		}, nil
+	}
+
+	if len(inhibitedBy) > 0 {
+		names := append([]string{}, inhibitedBy...)
+		sort.Strings(names)
+		return &v3.AlertSuppression{
+			Reason:  ReasonInhibited,
+			Message: "inhibited by " + strings.Join(names, ", "),
+		}, nil
+	}
+
+	return nil, nil
+}
+
+type indexedOccurrence struct {
+	Occurrence
+	index int
+}
+
+// latest returns the last occurrence of window that starts at or before now
+func latest(window v3.SilenceWindow, now time.Time) (indexedOccurrence, bool, error) {
+	start, end, until, err := parse(window)
+	if err != nil {
+		return indexedOccurrence{}, false, err
+	}
+	if now.Before(start) {
+		return indexedOccurrence{}, false, nil
+	}
+
+	var index int
+	switch window.Recurrence {
+	case "", "none":
+		return indexedOccurrence{Occurrence: Occurrence{Start: start, End: end}}, true, nil
+	case "daily", "weekly":
+		index = int(now.Sub(start) / period(window.Recurrence))
+	case "monthly":
+		now := now.In(start.Location())
+		index = (now.Year()-start.Year())*12 + int(now.Month()) - int(start.Month())
+	default:
+		return indexedOccurrence{}, false, fmt.Errorf("unsupported recurrence [%s]", window.Recurrence)
+	}
+
+	// the estimate is off by one around changes of the offset and the length of months
+	occurrence := occurrenceAt(start, end, window.Recurrence, index)
+	for occurrence.index > 0 && occurrence.Start.After(now) {
+		occurrence = occurrenceAt(start, end, window.Recurrence, occurrence.index-1)
+	}
+	if !until.IsZero() {
+		for occurrence.index > 0 && occurrence.Start.After(until) {
+			occurrence = occurrenceAt(start, end, window.Recurrence, occurrence.index-1)
+		}
+	}
+	return occurrence, true, nil
+}
+
+// occurrenceAt returns the occurrence index periods after the first, monthly windows keep the day of the
+// month and move to the end of shorter months
+func occurrenceAt(start, end time.Time, recurrence string, index int) indexedOccurrence {
+	length := end.Sub(start)
+	var s time.Time
+	switch recurrence {
+	case "daily":
+		s = start.AddDate(0, 0, index)
+	case "weekly":
+		s = start.AddDate(0, 0, 7*index)
+	case "monthly":
+		first := time.Date(start.Year(), start.Month()+time.Month(index), 1, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+		day := start.Day()
+		if last := first.AddDate(0, 1, -1).Day(); day > last {
+			day = last
+		}
+		s = first.AddDate(0, 0, day-1)
+	default:
+		s = start
+	}
+	return indexedOccurrence{Occurrence: Occurrence{Start: s, End: s.Add(length)}, index: index}
+}
+
+func period(recurrence string) time.Duration {
+	if recurrence == "weekly" {
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+func parse(window v3.SilenceWindow) (time.Time, time.Time, time.Time, error) {
+	start, err := time.Parse(time.RFC3339, window.Start)
+	if err != nil {
+		return start, start, start, fmt.Errorf("invalid start [%s]: %v", window.Start, err)
+	}
+	end, err := time.Parse(time.RFC3339, window.End)
+	if err != nil {
+		return start, end, end, fmt.Errorf("invalid end [%s]: %v", window.End, err)
+	}
+	var until time.Time
+	if window.Until != "" {
+		if until, err = time.Parse(time.RFC3339, window.Until); err != nil {
+			return start, end, until, fmt.Errorf("invalid until [%s]: %v", window.Until, err)
+		}
+	}
+	return start, end, until, nil
+}
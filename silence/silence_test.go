@@ -0,0 +1,210 @@
+package silence
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+func TestOccurrences(t *testing.T) {
+	tests := []struct {
+		name   string
+		window v3.SilenceWindow
+		now    string
+		// active is the start of the occurrence that contains now, next the start of the occurrence Next
+		// returns, empty when there is none
+		active string
+		next   string
+	}{
+		{
+			name:   "single window in the future",
+			window: v3.SilenceWindow{Start: "2026-03-10T10:00:00Z", End: "2026-03-10T12:00:00Z"},
+			now:    "2026-03-01T00:00:00Z",
+			next:   "2026-03-10T10:00:00Z",
+		},
+		{
+			name:   "single window in progress",
+			window: v3.SilenceWindow{Start: "2026-03-10T10:00:00Z", End: "2026-03-10T12:00:00Z", Recurrence: "none"},
+			now:    "2026-03-10T11:00:00Z",
+			active: "2026-03-10T10:00:00Z",
+			next:   "2026-03-10T10:00:00Z",
+		},
+		{
+			name:   "single window in the past",
+			window: v3.SilenceWindow{Start: "2026-03-10T10:00:00Z", End: "2026-03-10T12:00:00Z"},
+			now:    "2026-03-10T12:00:00Z",
+		},
+		{
+			name:   "daily window over midnight",
+			window: v3.SilenceWindow{Start: "2026-03-01T22:00:00Z", End: "2026-03-02T02:00:00Z", Recurrence: "daily"},
+			now:    "2026-03-05T01:00:00Z",
+			active: "2026-03-04T22:00:00Z",
+			next:   "2026-03-04T22:00:00Z",
+		},
+		{
+			name:   "daily window between occurrences",
+			window: v3.SilenceWindow{Start: "2026-03-01T22:00:00Z", End: "2026-03-02T02:00:00Z", Recurrence: "daily"},
+			now:    "2026-03-05T03:00:00Z",
+			next:   "2026-03-05T22:00:00Z",
+		},
+		{
+			name:   "daily window in the offset of start",
+			window: v3.SilenceWindow{Start: "2026-03-01T09:00:00-05:00", End: "2026-03-01T10:00:00-05:00", Recurrence: "daily"},
+			now:    "2026-03-03T14:30:00Z",
+			active: "2026-03-03T09:00:00-05:00",
+			next:   "2026-03-03T09:00:00-05:00",
+		},
+		{
+			name:   "weekly window",
+			window: v3.SilenceWindow{Start: "2026-03-02T00:00:00Z", End: "2026-03-02T01:00:00Z", Recurrence: "weekly"},
+			now:    "2026-03-20T12:00:00Z",
+			next:   "2026-03-23T00:00:00Z",
+		},
+		{
+			name:   "monthly window in the future",
+			window: v3.SilenceWindow{Start: "2026-05-31T08:00:00Z", End: "2026-05-31T10:00:00Z", Recurrence: "monthly"},
+			now:    "2026-03-01T00:00:00Z",
+			next:   "2026-05-31T08:00:00Z",
+		},
+		{
+			name:   "monthly window clamped to february",
+			window: v3.SilenceWindow{Start: "2026-01-31T08:00:00Z", End: "2026-01-31T10:00:00Z", Recurrence: "monthly"},
+			now:    "2026-02-15T00:00:00Z",
+			next:   "2026-02-28T08:00:00Z",
+		},
+		{
+			name:   "monthly window in progress at the end of february",
+			window: v3.SilenceWindow{Start: "2026-01-31T08:00:00Z", End: "2026-01-31T10:00:00Z", Recurrence: "monthly"},
+			now:    "2026-02-28T09:00:00Z",
+			active: "2026-02-28T08:00:00Z",
+			next:   "2026-02-28T08:00:00Z",
+		},
+		{
+			name:   "monthly window back on its day after a short month",
+			window: v3.SilenceWindow{Start: "2026-01-31T08:00:00Z", End: "2026-01-31T10:00:00Z", Recurrence: "monthly"},
+			now:    "2026-03-01T00:00:00Z",
+			next:   "2026-03-31T08:00:00Z",
+		},
+		{
+			name:   "monthly window clamped to a leap day",
+			window: v3.SilenceWindow{Start: "2026-01-31T08:00:00Z", End: "2026-01-31T10:00:00Z", Recurrence: "monthly"},
+			now:    "2028-02-10T00:00:00Z",
+			next:   "2028-02-29T08:00:00Z",
+		},
+		{
+			name:   "last occurrence before until",
+			window: v3.SilenceWindow{Start: "2026-03-01T22:00:00Z", End: "2026-03-01T23:00:00Z", Recurrence: "daily", Until: "2026-03-03T00:00:00Z"},
+			now:    "2026-03-02T22:30:00Z",
+			active: "2026-03-02T22:00:00Z",
+			next:   "2026-03-02T22:00:00Z",
+		},
+		{
+			name:   "no occurrence after until",
+			window: v3.SilenceWindow{Start: "2026-03-01T22:00:00Z", End: "2026-03-01T23:00:00Z", Recurrence: "daily", Until: "2026-03-03T00:00:00Z"},
+			now:    "2026-03-10T22:30:00Z",
+		},
+	}
+
+	for _, test := range tests {
+		now := parseTime(t, test.now)
+
+		active, ok, err := Active(test.window, now)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if start := startOf(active, ok); start != test.active {
+			t.Errorf("%s: expected the active occurrence to start at %q, got %q", test.name, test.active, start)
+		}
+
+		next, ok, err := Next(test.window, now)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if start := startOf(next, ok); start != test.next {
+			t.Errorf("%s: expected the next occurrence to start at %q, got %q", test.name, test.next, start)
+		}
+		if ok && next.End.Sub(next.Start) != parseTime(t, test.window.End).Sub(parseTime(t, test.window.Start)) {
+			t.Errorf("%s: expected the occurrence to last as long as the window, got %v", test.name, next)
+		}
+	}
+}
+
+func TestInvalidWindow(t *testing.T) {
+	for _, window := range []v3.SilenceWindow{
+		{Start: "tomorrow", End: "2026-03-10T12:00:00Z"},
+		{Start: "2026-03-10T10:00:00Z", End: "2026-03-10T12:00:00Z", Recurrence: "yearly"},
+	} {
+		if _, _, err := Next(window, parseTime(t, "2026-03-11T00:00:00Z")); err == nil {
+			t.Errorf("%+v: expected an error", window)
+		}
+	}
+}
+
+func TestSuppression(t *testing.T) {
+	now := parseTime(t, "2026-03-10T11:00:00Z")
+	windows := []v3.SilenceWindow{
+		{Start: "2026-03-10T10:00:00Z", End: "2026-03-10T12:00:00Z", Comment: "maintenance"},
+		{Start: "2026-03-10T10:30:00Z", End: "2026-03-10T13:00:00Z"},
+		{Start: "2026-03-11T10:00:00Z", End: "2026-03-11T12:00:00Z", Comment: "tomorrow"},
+	}
+
+	tests := []struct {
+		name        string
+		spec        v3.AlertCommonSpec
+		status      v3.AlertStatus
+		inhibitedBy []string
+		expected    *v3.AlertSuppression
+	}{
+		{
+			name: "notified",
+			spec: v3.AlertCommonSpec{SilenceWindows: windows[2:]},
+		},
+		{
+			name:     "muted",
+			spec:     v3.AlertCommonSpec{SilenceWindows: windows},
+			status:   v3.AlertStatus{AlertState: "muted"},
+			expected: &v3.AlertSuppression{Reason: ReasonMuted, Message: "the alert is muted"},
+		},
+		{
+			name:        "silenced until the last window ends",
+			spec:        v3.AlertCommonSpec{SilenceWindows: windows},
+			inhibitedBy: []string{"c-1:alert-node"},
+			expected:    &v3.AlertSuppression{Reason: ReasonSilenced, Message: "silence window is active: maintenance", Until: "2026-03-10T13:00:00Z"},
+		},
+		{
+			name:        "inhibited",
+			inhibitedBy: []string{"c-1:alert-node", "c-1:alert-etcd"},
+			expected:    &v3.AlertSuppression{Reason: ReasonInhibited, Message: "inhibited by c-1:alert-etcd, c-1:alert-node"},
+		},
+	}
+
+	for _, test := range tests {
+		suppression, err := Suppression(test.spec, test.status, test.inhibitedBy, now)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(suppression, test.expected) {
+			t.Errorf("%s: expected %+v, got %+v", test.name, test.expected, suppression)
+		}
+	}
+}
+
+func parseTime(t *testing.T, value string) time.Time {
+	result, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func startOf(occurrence Occurrence, ok bool) string {
+	if !ok {
+		return ""
+	}
+	return occurrence.Start.Format(time.RFC3339)
+}
@@ -29,6 +29,7 @@ var transitioningMap = map[string]string{
 	"Active":                      "activating",
 	"AddonDeploy":                 "provisioning",
 	"AgentDeployed":               "provisioning",
+	"Authenticated":               "unauthorized",
 	"BackingNamespaceCreated":     "configuring",
 	"CertsGenerated":              "provisioning",
 	"ConfigOK":                    "configuring",
@@ -40,19 +41,23 @@ var transitioningMap = map[string]string{
 	"DockerProvisioned":           "provisioning",
 	"Downloaded":                  "downloading",
 	"etcd":                        "provisioning",
+	"EtcdRestored":                "restoring",
 	"Inactive":                    "deactivating",
 	"Initialized":                 "initializing",
 	"Installed":                   "installing",
+	"MonitoringEnabled":           "configuring",
 	"NodesCreated":                "provisioning",
 	"Pending":                     "pending",
 	"PodScheduled":                "scheduling",
 	"Provisioned":                 "provisioning",
+	"Reachable":                   "unreachable",
 	"Refreshed":                   "refreshed",
 	"Registered":                  "registering",
 	"Removed":                     "removing",
 	"Saved":                       "saving",
 	"Updated":                     "updating",
 	"Updating":                    "updating",
+	"Upgraded":                    "upgrading",
 	"Waiting":                     "waiting",
 	"InitialRolesPopulated":       "activating",
 }